@@ -0,0 +1,217 @@
+package queue
+
+type RingQueueDequeAdapter = GenericRingQueueDequeAdapter[any]
+
+func NewRingQueueDequeAdapter(inst IRingQueue) (*RingQueueDequeAdapter, error) {
+	return NewGenericRingQueueDequeAdapter[any](inst)
+}
+
+// GenericRingQueueDequeAdapter lets a ring queue be used as a Deque, pushing to
+// the front goes through InsertAt and popping from the back through RemoveAt.
+// Both are O(1) at the ends of a RingQueue.
+type GenericRingQueueDequeAdapter[T any] struct {
+	inst      IGenericRingQueue[T]
+	indexable GenericIndexable[T]
+}
+
+// NewGenericRingQueueDequeAdapter returns ErrUnsupportedOperation if the ring
+// queue does not implement GenericIndexable, such as the lock-free ring queues.
+// A wrapper such as SafetyRingQueue always has the index methods, so the queue
+// it wraps must implement GenericIndexable as well.
+func NewGenericRingQueueDequeAdapter[T any](inst IGenericRingQueue[T]) (*GenericRingQueueDequeAdapter[T], error) {
+	if inst == nil {
+		return nil, ErrNilInstance
+	}
+	indexable, ok := inst.(GenericIndexable[T])
+	if !ok || !isIndexableRingQueue[T](inst) {
+		return nil, ErrUnsupportedOperation
+	}
+
+	return &GenericRingQueueDequeAdapter[T]{
+		inst:      inst,
+		indexable: indexable,
+	}, nil
+}
+
+// isIndexableRingQueue reports whether inst and every queue wrapped inside it
+// implement GenericIndexable.
+func isIndexableRingQueue[T any](inst IGenericRingQueue[T]) bool {
+	for {
+		if _, ok := inst.(GenericIndexable[T]); !ok {
+			return false
+		}
+		wrapper, ok := inst.(interface{ GetQueueInstance() IGenericRingQueue[T] })
+		if !ok {
+			return true
+		}
+		inst = wrapper.GetQueueInstance()
+	}
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) GetQueueInstance() IGenericRingQueue[T] {
+	return t.inst
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) GetLength() int {
+	return t.inst.GetLength()
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) IsEmpty() bool {
+	return t.inst.IsEmpty()
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) IsFull() bool {
+	return t.inst.IsFull()
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) GetAvailableCapacitySize() int {
+	return t.inst.GetAvailableCapacitySize()
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) PushValue(value T) error {
+	return t.inst.PushValue(value)
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) PopValue() (T, bool) {
+	return t.inst.PopValue()
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) PushValueToBack(value T) error {
+	return t.inst.PushValue(value)
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) PopValueFromFront() (T, bool) {
+	return t.inst.PopValue()
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) PushValueToFront(value T) error {
+	return t.indexable.InsertAt(0, value)
+}
+
+// PopValueFromBack returns false if RemoveAt fails, which happens when the
+// queue is empty, including when another goroutine empties a safety wrapper
+// between reading the length and removing the value.
+func (t *GenericRingQueueDequeAdapter[T]) PopValueFromBack() (T, bool) {
+	value, err := t.indexable.RemoveAt(t.inst.GetLength() - 1)
+	if err != nil {
+		var zero T
+		return zero, false
+	}
+	return value, true
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) deferEvictFunc() {
+	if evictor, ok := t.inst.(evictDeferrer); ok {
+		evictor.deferEvictFunc()
+	}
+}
+
+func (t *GenericRingQueueDequeAdapter[T]) takeEvictCallback() func() {
+	if evictor, ok := t.inst.(evictDeferrer); ok {
+		return evictor.takeEvictCallback()
+	}
+	return nil
+}
+
+type DequeFIFOAdapter = GenericDequeFIFOAdapter[any]
+
+func NewDequeFIFOAdapter(inst IDeque) *DequeFIFOAdapter {
+	return NewGenericDequeFIFOAdapter[any](inst)
+}
+
+// GenericDequeFIFOAdapter lets a deque be used as a FIFO that pushes to the
+// back and pops from the front. It is Bounded like the deque.
+type GenericDequeFIFOAdapter[T any] struct {
+	inst IGenericDeque[T]
+}
+
+func NewGenericDequeFIFOAdapter[T any](inst IGenericDeque[T]) *GenericDequeFIFOAdapter[T] {
+	return &GenericDequeFIFOAdapter[T]{
+		inst: inst,
+	}
+}
+
+func (t *GenericDequeFIFOAdapter[T]) GetQueueInstance() IGenericDeque[T] {
+	return t.inst
+}
+
+func (t *GenericDequeFIFOAdapter[T]) GetLength() int {
+	return t.inst.GetLength()
+}
+
+func (t *GenericDequeFIFOAdapter[T]) IsEmpty() bool {
+	return t.inst.IsEmpty()
+}
+
+func (t *GenericDequeFIFOAdapter[T]) IsFull() bool {
+	return t.inst.IsFull()
+}
+
+func (t *GenericDequeFIFOAdapter[T]) GetAvailableCapacitySize() int {
+	return t.inst.GetAvailableCapacitySize()
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PushValue(value T) error {
+	return t.inst.PushValueToBack(value)
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PushValues(values ...T) error {
+	return t.inst.PushValuesToBack(values...)
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PushValueToFront(value T) error {
+	return t.inst.PushValueToFront(value)
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PopValue() (T, bool) {
+	return t.inst.PopValueFromFront()
+}
+
+// The peek methods report no values if the deque is not GenericPeekable.
+func (t *GenericDequeFIFOAdapter[T]) PeekValue() (retValue T, retOk bool) {
+	if inst, ok := t.inst.(GenericPeekable[T]); ok {
+		retValue, retOk = inst.PeekValue()
+	}
+	return
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PeekValues(count int) (retValues []T) {
+	if inst, ok := t.inst.(GenericPeekable[T]); ok {
+		retValues = inst.PeekValues(count)
+	}
+	return
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PeekFront() (retValue T, retOk bool) {
+	if inst, ok := t.inst.(GenericPeekable[T]); ok {
+		retValue, retOk = inst.PeekFront()
+	}
+	return
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PeekBack() (retValue T, retOk bool) {
+	if inst, ok := t.inst.(GenericPeekable[T]); ok {
+		retValue, retOk = inst.PeekBack()
+	}
+	return
+}
+
+func (t *GenericDequeFIFOAdapter[T]) PeekAt(index int) (retValue T, retOk bool) {
+	if inst, ok := t.inst.(GenericPeekable[T]); ok {
+		retValue, retOk = inst.PeekAt(index)
+	}
+	return
+}
+
+func (t *GenericDequeFIFOAdapter[T]) deferEvictFunc() {
+	if evictor, ok := t.inst.(evictDeferrer); ok {
+		evictor.deferEvictFunc()
+	}
+}
+
+func (t *GenericDequeFIFOAdapter[T]) takeEvictCallback() func() {
+	if evictor, ok := t.inst.(evictDeferrer); ok {
+		return evictor.takeEvictCallback()
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package queue
+
+import (
+	"context"
+	"errors"
+)
+
+// BackpressurePolicy decides what FromChan does with a value when the queue is
+// full.
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock waits until the queue has room for the value.
+	BackpressureBlock BackpressurePolicy = iota
+	// BackpressureDropNewest discards the value that does not fit.
+	BackpressureDropNewest
+	// BackpressureDropOldest pops and discards the front value to make room,
+	// which for a priority queue is the next value to pop rather than the
+	// oldest. The pop and the push are atomic if the queue has
+	// PushValueDropFront, like the safety queues.
+	BackpressureDropOldest
+)
+
+func ToChan(ctx context.Context, q BlockingFIFO) <-chan any {
+	return GenericToChan[any](ctx, q)
+}
+
+// valueRestorer is implemented by the safety queues, which can put a popped
+// value back to the front of the queue.
+type valueRestorer[T any] interface {
+	restoreValue(value T) error
+}
+
+// GenericToChan pumps the values of q into the returned channel from a new
+// goroutine, the channel is closed once q is closed and drained or ctx is
+// done. A value popped when ctx is done is pushed back to the front of q if q
+// is a safety queue over a queue that can push to the front, such as a
+// RingQueue or a deque, and has room for it. Otherwise it is dropped.
+func GenericToChan[T any](ctx context.Context, q GenericBlockingFIFO[T]) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		for {
+			value, err := q.PopValueWait(ctx)
+			if err != nil {
+				return
+			}
+
+			select {
+			case ch <- value:
+			case <-ctx.Done():
+				if restorer, ok := q.(valueRestorer[T]); ok {
+					_ = restorer.restoreValue(value)
+				}
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+func FromChan(ctx context.Context, ch <-chan any, q BlockingFIFO, policy BackpressurePolicy) error {
+	return GenericFromChan[any](ctx, ch, q, policy)
+}
+
+// GenericFromChan pushes the values received from ch to q, applying policy when
+// q is full. It returns nil once ch is closed, ctx.Err() once ctx is done and
+// the push error if q rejects a value for another reason, such as ErrClosed.
+// It does not close q.
+func GenericFromChan[T any](ctx context.Context, ch <-chan T, q GenericBlockingFIFO[T], policy BackpressurePolicy) error {
+	for {
+		select {
+		case value, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := pushValueWithPolicy(ctx, q, value, policy); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func pushValueWithPolicy[T any](ctx context.Context, q GenericBlockingFIFO[T], value T, policy BackpressurePolicy) error {
+	switch policy {
+	case BackpressureDropNewest:
+		if err := q.PushValue(value); !errors.Is(err, ErrFull) {
+			return err
+		}
+		return nil
+	case BackpressureDropOldest:
+		if inst, ok := q.(interface{ PushValueDropFront(value T) error }); ok {
+			return inst.PushValueDropFront(value)
+		}
+		for {
+			if err := q.PushValue(value); !errors.Is(err, ErrFull) {
+				return err
+			}
+			if _, ok := q.PopValue(); !ok {
+				// A full queue without values cannot take any, drop the new one
+				return pushValueWithPolicy(ctx, q, value, BackpressureDropNewest)
+			}
+		}
+	default:
+		return q.PushValueWait(ctx, value)
+	}
+}
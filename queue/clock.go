@@ -0,0 +1,39 @@
+package queue
+
+import (
+	"time"
+)
+
+// Clock is the time source of the time based queues, tests can replace it to
+// control time without sleeping.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) ClockTimer
+}
+
+type ClockTimer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type SystemClock struct{}
+
+func (t SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (t SystemClock) NewTimer(d time.Duration) ClockTimer {
+	return &systemClockTimer{timer: time.NewTimer(d)}
+}
+
+type systemClockTimer struct {
+	timer *time.Timer
+}
+
+func (t *systemClockTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t *systemClockTimer) Stop() bool {
+	return t.timer.Stop()
+}
@@ -0,0 +1,172 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type DelayQueue = GenericDelayQueue[any]
+
+func NewDelayQueue(capacity int, clock Clock) *DelayQueue {
+	return NewGenericDelayQueue[any](capacity, clock)
+}
+
+type delayQueueItem[T any] struct {
+	value    T
+	deadline time.Time
+	sequence uint64
+}
+
+// GenericDelayQueue holds values that can only be popped once their deadline
+// has passed, values with the same deadline are popped in push order. It is
+// safe for concurrent use. A negative capacity means unbounded and a nil clock
+// means the system clock.
+type GenericDelayQueue[T any] struct {
+	rwMutex          sync.RWMutex
+	clock            Clock
+	inst             *GenericPriorityQueue[delayQueueItem[T]]
+	sequence         uint64
+	notEmptyNotifier waitNotifier
+}
+
+func NewGenericDelayQueue[T any](capacity int, clock Clock) *GenericDelayQueue[T] {
+	if clock == nil {
+		clock = SystemClock{}
+	}
+
+	return &GenericDelayQueue[T]{
+		clock: clock,
+		inst: newGenericPriorityQueue[delayQueueItem[T]](capacity, func(a, b delayQueueItem[T]) bool {
+			if a.deadline.Equal(b.deadline) {
+				return a.sequence < b.sequence
+			}
+			return a.deadline.Before(b.deadline)
+		}),
+	}
+}
+
+func (t *GenericDelayQueue[T]) GetLength() int {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.inst.GetLength()
+}
+
+func (t *GenericDelayQueue[T]) IsEmpty() bool {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.inst.IsEmpty()
+}
+
+func (t *GenericDelayQueue[T]) IsFull() bool {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.inst.IsFull()
+}
+
+func (t *GenericDelayQueue[T]) GetAvailableCapacitySize() int {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.inst.GetAvailableCapacitySize()
+}
+
+func (t *GenericDelayQueue[T]) PushValueWithDelay(value T, delay time.Duration) error {
+	return t.PushValueAt(value, t.clock.Now().Add(delay))
+}
+
+func (t *GenericDelayQueue[T]) PushValueAt(value T, deadline time.Time) error {
+	t.rwMutex.Lock()
+	defer t.rwMutex.Unlock()
+
+	if err := t.inst.PushValue(delayQueueItem[T]{
+		value:    value,
+		deadline: deadline,
+		sequence: t.sequence,
+	}); err != nil {
+		return err
+	}
+
+	t.sequence += 1
+	t.notEmptyNotifier.notify()
+	return nil
+}
+
+// PeekDeadline returns the deadline of the value that will be popped next,
+// whether or not it has already passed.
+func (t *GenericDelayQueue[T]) PeekDeadline() (time.Time, bool) {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+
+	item, ok := t.inst.PeekValue()
+	return item.deadline, ok
+}
+
+func (t *GenericDelayQueue[T]) popExpiredValue(now time.Time) (T, bool) {
+	item, ok := t.inst.PeekValue()
+	if !ok || item.deadline.After(now) {
+		var zero T
+		return zero, false
+	}
+
+	t.inst.PopValue()
+	return item.value, true
+}
+
+func (t *GenericDelayQueue[T]) PopValue() (T, bool) {
+	t.rwMutex.Lock()
+	defer t.rwMutex.Unlock()
+	return t.popExpiredValue(t.clock.Now())
+}
+
+func (t *GenericDelayQueue[T]) PopValues(count int) (retValues []T) {
+	t.rwMutex.Lock()
+	defer t.rwMutex.Unlock()
+
+	now := t.clock.Now()
+	for i := 0; i < count; i++ {
+		value, valid := t.popExpiredValue(now)
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+// PopValueWait blocks until the value with the earliest deadline expires or
+// the context is done, a push with an earlier deadline wakes it up early.
+func (t *GenericDelayQueue[T]) PopValueWait(ctx context.Context) (T, error) {
+	var zero T
+	for {
+		t.rwMutex.Lock()
+		now := t.clock.Now()
+		if value, ok := t.popExpiredValue(now); ok {
+			t.rwMutex.Unlock()
+			return value, nil
+		}
+
+		var timerCh <-chan time.Time
+		var timer ClockTimer
+		if item, ok := t.inst.PeekValue(); ok {
+			timer = t.clock.NewTimer(item.deadline.Sub(now))
+			timerCh = timer.C()
+		}
+		waitCh := t.notEmptyNotifier.waitChan()
+		t.rwMutex.Unlock()
+
+		var err error
+		select {
+		case <-waitCh:
+		case <-timerCh:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			return zero, err
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrClosed               = errors.New("the queue is closed")
+	ErrFull                 = errors.New("the queue capacity is already full")
+	ErrInsufficientCapacity = errors.New("the capacity size of the queue is insufficient")
+	ErrNilFunc              = errors.New("the parameter f is a nil value")
+	ErrNilListSpace         = errors.New("the parameter listSpace is a nil value")
+	ErrZeroCapListSpace     = errors.New("the capacity of the parameter listSpace is 0")
+	ErrNilInstance          = errors.New("the created queue instance is a nil value")
+	ErrInvalidHandle        = errors.New("the handle does not belong to the queue")
+	ErrIndexOutOfRange      = errors.New("the index is out of the range of the queue")
+	ErrUnsupportedOperation = errors.New("the operation is not supported by the queue")
+)
+
+// CapacityError is returned when a batch push does not fit into the queue.
+// It matches ErrInsufficientCapacity with errors.Is.
+type CapacityError struct {
+	Requested int
+	Available int
+}
+
+func newCapacityError(requested, available int) *CapacityError {
+	return &CapacityError{
+		Requested: requested,
+		Available: available,
+	}
+}
+
+func (t *CapacityError) Error() string {
+	return fmt.Sprintf("%v, requested %d, available %d", ErrInsufficientCapacity, t.Requested, t.Available)
+}
+
+func (t *CapacityError) Is(target error) bool {
+	return target == ErrInsufficientCapacity
+}
+
+// IndexError is returned when an index based operation is given an index
+// outside of the queue. It matches ErrIndexOutOfRange with errors.Is.
+type IndexError struct {
+	Index  int
+	Length int
+}
+
+func newIndexError(index, length int) *IndexError {
+	return &IndexError{
+		Index:  index,
+		Length: length,
+	}
+}
+
+func (t *IndexError) Error() string {
+	return fmt.Sprintf("%v, index %d, length %d", ErrIndexOutOfRange, t.Index, t.Length)
+}
+
+func (t *IndexError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
@@ -0,0 +1,258 @@
+package queue
+
+type IndexedPriorityQueue = GenericIndexedPriorityQueue[any, any]
+
+func NewIndexedPriorityQueue(capacity int, lessFunc func(a, b any) bool) (*IndexedPriorityQueue, error) {
+	return NewGenericIndexedPriorityQueue[any, any](capacity, lessFunc)
+}
+
+// PriorityQueueHandle refers to a value pushed into an indexed priority queue,
+// it stays valid until the value is popped or removed.
+type PriorityQueueHandle[T, P any] struct {
+	owner    *GenericIndexedPriorityQueue[T, P]
+	index    int
+	value    T
+	priority P
+}
+
+func (t *PriorityQueueHandle[T, P]) GetValue() T {
+	return t.value
+}
+
+func (t *PriorityQueueHandle[T, P]) GetPriority() P {
+	return t.priority
+}
+
+// GenericIndexedPriorityQueue is a binary heap ordered by the priority that is
+// pushed along with every value. The handle returned by PushValue allows the
+// priority of a value to be changed, or the value removed, in O(log n).
+// A negative capacity means unbounded.
+type GenericIndexedPriorityQueue[T, P any] struct {
+	capacity int
+	lessFunc func(a, b P) bool
+	handles  []*PriorityQueueHandle[T, P]
+}
+
+func NewGenericIndexedPriorityQueue[T, P any](capacity int, lessFunc func(a, b P) bool) (*GenericIndexedPriorityQueue[T, P], error) {
+	if lessFunc == nil {
+		return nil, ErrNilFunc
+	}
+
+	t := &GenericIndexedPriorityQueue[T, P]{
+		capacity: capacity,
+		lessFunc: lessFunc,
+	}
+	if capacity > 0 {
+		t.handles = make([]*PriorityQueueHandle[T, P], 0, capacity)
+	}
+	return t, nil
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) less(i, j int) bool {
+	return t.lessFunc(t.handles[i].priority, t.handles[j].priority)
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) swap(i, j int) {
+	t.handles[i], t.handles[j] = t.handles[j], t.handles[i]
+	t.handles[i].index = i
+	t.handles[j].index = j
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) up(idx int) bool {
+	moved := false
+	for idx > 0 {
+		parent := (idx - 1) / 2
+		if !t.less(idx, parent) {
+			break
+		}
+		t.swap(idx, parent)
+		idx = parent
+		moved = true
+	}
+	return moved
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) down(idx int) {
+	handlesLen := len(t.handles)
+	for {
+		smallest := idx
+		left := 2*idx + 1
+		right := left + 1
+		if left < handlesLen && t.less(left, smallest) {
+			smallest = left
+		}
+		if right < handlesLen && t.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == idx {
+			return
+		}
+		t.swap(idx, smallest)
+		idx = smallest
+	}
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) fix(idx int) {
+	if !t.up(idx) {
+		t.down(idx)
+	}
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) removeAt(idx int) T {
+	lastIdx := len(t.handles) - 1
+	if idx != lastIdx {
+		t.swap(idx, lastIdx)
+	}
+
+	handle := t.handles[lastIdx]
+	t.handles[lastIdx] = nil
+	t.handles = t.handles[:lastIdx]
+	if idx != lastIdx {
+		t.fix(idx)
+	}
+
+	handle.owner = nil
+	handle.index = -1
+	return handle.value
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) GetLength() int {
+	return len(t.handles)
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) IsEmpty() bool {
+	return len(t.handles) == 0
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) IsFull() bool {
+	if t.capacity < 0 {
+		return false
+	}
+
+	return len(t.handles) >= t.capacity
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) GetAvailableCapacitySize() int {
+	if t.capacity < 0 {
+		return -1
+	}
+
+	return t.capacity - len(t.handles)
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PushValue(value T, priority P) (*PriorityQueueHandle[T, P], error) {
+	if t.IsFull() {
+		return nil, ErrFull
+	}
+
+	handle := &PriorityQueueHandle[T, P]{
+		owner:    t,
+		index:    len(t.handles),
+		value:    value,
+		priority: priority,
+	}
+	t.handles = append(t.handles, handle)
+	t.up(handle.index)
+	return handle, nil
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) Contains(handle *PriorityQueueHandle[T, P]) bool {
+	return handle != nil && handle.owner == t
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) Update(handle *PriorityQueueHandle[T, P], priority P) error {
+	if !t.Contains(handle) {
+		return ErrInvalidHandle
+	}
+
+	handle.priority = priority
+	t.fix(handle.index)
+	return nil
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) Remove(handle *PriorityQueueHandle[T, P]) (T, bool) {
+	if !t.Contains(handle) {
+		var zero T
+		return zero, false
+	}
+
+	return t.removeAt(handle.index), true
+}
+
+// scanInOrder visits the heap indexes of the values in pop order without
+// modifying the heap, in the same way as GenericPriorityQueue.scanInOrder.
+func (t *GenericIndexedPriorityQueue[T, P]) scanInOrder(f func(idx int) bool) {
+	if len(t.handles) <= 0 {
+		return
+	}
+
+	candidates := newGenericPriorityQueue[int](-1, t.less)
+	_ = candidates.PushValue(0)
+	for {
+		idx, ok := candidates.PopValue()
+		if !ok || !f(idx) {
+			return
+		}
+		for child := 2*idx + 1; child <= 2*idx+2 && child < len(t.handles); child++ {
+			_ = candidates.PushValue(child)
+		}
+	}
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PeekValue() (T, bool) {
+	if len(t.handles) <= 0 {
+		var zero T
+		return zero, false
+	}
+
+	return t.handles[0].value, true
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PeekHandle() (*PriorityQueueHandle[T, P], bool) {
+	if len(t.handles) <= 0 {
+		return nil, false
+	}
+
+	return t.handles[0], true
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PopValue() (T, bool) {
+	if len(t.handles) <= 0 {
+		var zero T
+		return zero, false
+	}
+
+	return t.removeAt(0), true
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PopValues(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValue)
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) PopValuesWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"context"
+)
+
+// The interfaces below describe the behaviour shared by the containers, so code
+// written against them can swap one container for another. IGenericRingQueue
+// and IGenericDeque only hold the push and pop methods every implementation
+// supports. Peeking, index access and scanning are optional and described by
+// GenericPeekable, GenericIndexable and GenericScannable, check for them with a
+// type assertion. The safety wrappers always have these methods and check the
+// wrapped container for them: the peek methods and iterators report no values
+// and the others return ErrUnsupportedOperation when it lacks them.
+
+type Sizer interface {
+	GetLength() int
+	IsEmpty() bool
+}
+
+// Bounded is implemented by the containers with a capacity limit, a negative
+// available capacity size means the container is unbounded.
+type Bounded interface {
+	Sizer
+	IsFull() bool
+	GetAvailableCapacitySize() int
+}
+
+// GenericFIFO pushes values to the back and pops them from the front.
+type GenericFIFO[T any] interface {
+	Sizer
+	PushValue(value T) error
+	PopValue() (T, bool)
+}
+
+// GenericLIFO pushes and pops values at the back.
+type GenericLIFO[T any] interface {
+	Sizer
+	PushValueToBack(value T) error
+	PopValueFromBack() (T, bool)
+}
+
+type GenericDeque[T any] interface {
+	GenericFIFO[T]
+	GenericLIFO[T]
+	PushValueToFront(value T) error
+	PopValueFromFront() (T, bool)
+}
+
+// GenericPeekable reads values without removing them, the lock-free queues for
+// several consumers can not observe a value without claiming it and do not
+// implement it.
+type GenericPeekable[T any] interface {
+	PeekValue() (T, bool)
+	PeekValues(count int) (retValues []T)
+	PeekFront() (T, bool)
+	PeekBack() (T, bool)
+	PeekAt(index int) (T, bool)
+}
+
+// GenericIndexable accesses and modifies values by their position from the
+// front, only the queues that keep their values in order can insert or set a
+// value at an arbitrary position.
+type GenericIndexable[T any] interface {
+	Get(index int) (T, error)
+	Set(index int, value T) error
+	RemoveAt(index int) (T, error)
+	InsertAt(index int, value T) error
+}
+
+// GenericScannable visits the values in order without removing them, like
+// GenericPeekable it is not implemented by the lock-free queues for several
+// consumers.
+type GenericScannable[T any] interface {
+	ScanElements(f func(value T) bool) error
+	ScanElementsReverse(f func(value T) bool) error
+	ScanElementsWithIndex(f func(index int, value T) bool) error
+}
+
+// GenericBlockingFIFO is a FIFO that is safe for concurrent use and can wait
+// for room or for values, like the safety wrappers.
+type GenericBlockingFIFO[T any] interface {
+	GenericFIFO[T]
+	PushValueWait(ctx context.Context, value T) error
+	PopValueWait(ctx context.Context) (T, error)
+}
+
+type FIFO = GenericFIFO[any]
+type LIFO = GenericLIFO[any]
+type Deque = GenericDeque[any]
+type BlockingFIFO = GenericBlockingFIFO[any]
+type Peekable = GenericPeekable[any]
+type Indexable = GenericIndexable[any]
+type Scannable = GenericScannable[any]
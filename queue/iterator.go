@@ -0,0 +1,240 @@
+//go:build go1.23
+
+package queue
+
+import (
+	"iter"
+)
+
+// The containers must not be modified while ranging over All or Backward.
+// Drain pops each value before yielding it, so the value the loop breaks on
+// has already been removed.
+
+func drainValues[T any](popFunc func() (T, bool)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			value, ok := popFunc()
+			if !ok || !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func yieldValues[T any](values []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func yieldValuesBackward[T any](values []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (t *GenericRingQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = t.ScanElements(yield)
+	}
+}
+
+func (t *GenericRingQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = t.ScanElementsReverse(yield)
+	}
+}
+
+func (t *GenericRingQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
+
+func (t *GenericSPSCRingQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = t.ScanElements(yield)
+	}
+}
+
+func (t *GenericSPSCRingQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = t.ScanElementsReverse(yield)
+	}
+}
+
+func (t *GenericSPSCRingQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
+
+func (t *GenericMPMCRingQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
+
+func (t *GenericPriorityQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = t.ScanElements(yield)
+	}
+}
+
+func (t *GenericPriorityQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		_ = t.ScanElementsReverse(yield)
+	}
+}
+
+func (t *GenericPriorityQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
+
+func (t *GenericLinkListDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.scanNodesFromFront(func(node *LinkListNode[T]) bool {
+			return yield(node.Value)
+		})
+	}
+}
+
+func (t *GenericLinkListDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.scanNodesFromBack(func(node *LinkListNode[T]) bool {
+			return yield(node.Value)
+		})
+	}
+}
+
+func (t *GenericLinkListDeque[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValueFromFront)
+}
+
+func (t *GenericRingDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.ScanElementsFromFront(yield)
+	}
+}
+
+func (t *GenericRingDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.ScanElementsFromBack(yield)
+	}
+}
+
+func (t *GenericRingDeque[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValueFromFront)
+}
+
+// All and Backward visit the values in pop order.
+func (t *GenericIndexedPriorityQueue[T, P]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		t.scanInOrder(func(idx int) bool {
+			return yield(t.handles[idx].value)
+		})
+	}
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		heapIndexes := make([]int, 0, len(t.handles))
+		t.scanInOrder(func(idx int) bool {
+			heapIndexes = append(heapIndexes, idx)
+			return true
+		})
+		for i := len(heapIndexes) - 1; i >= 0; i-- {
+			if !yield(t.handles[heapIndexes[i]].value) {
+				return
+			}
+		}
+	}
+}
+
+func (t *GenericIndexedPriorityQueue[T, P]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
+
+// All iterates over a snapshot of every value in deadline order, including the
+// values that have not expired yet, taken under the read lock like the
+// snapshot of a GenericSafetyQueue.
+func (t *GenericDelayQueue[T]) All() iter.Seq[T] {
+	return yieldValues(t.snapshotValues())
+}
+
+func (t *GenericDelayQueue[T]) Backward() iter.Seq[T] {
+	return yieldValuesBackward(t.snapshotValues())
+}
+
+func (t *GenericDelayQueue[T]) snapshotValues() (retValues []T) {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+
+	retValues = make([]T, 0, t.inst.GetLength())
+	_ = t.inst.ScanElements(func(item delayQueueItem[T]) bool {
+		retValues = append(retValues, item.value)
+		return true
+	})
+	return
+}
+
+// Drain pops the expired values only, it stops at the first value whose
+// deadline has not passed.
+func (t *GenericDelayQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
+
+// All walks the node chain from the front without popping. It is safe to run
+// alongside pushes, which may or may not be visited, but not alongside pops,
+// which clear the value of the node they leave behind. There is no Backward
+// since the nodes only link towards the back.
+func (t *GenericLockFreeQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := t.head.Load().next.Load(); node != nil; node = node.next.Load() {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (t *GenericLockFreeQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValueFromFront)
+}
+
+// A GenericWorkStealingDeque has no All or Backward, the owner and the thieves
+// clear slots and replace the buffer without any lock, so there is no point at
+// which the values can be read in place. Drain steals from the front, so any
+// goroutine may range over it.
+func (t *GenericWorkStealingDeque[T]) Drain() iter.Seq[T] {
+	return drainValues(t.StealValueFromFront)
+}
+
+// All iterates over a snapshot of the values taken under the read lock, so the
+// loop body is free to call any method of the queue. The snapshot is taken with
+// PeekValues, so All and Backward yield nothing if the wrapped queue is not
+// Peekable, such as an MPMCRingQueue, while Drain works with any queue.
+func (t *GenericSafetyQueue[T]) All() iter.Seq[T] {
+	return yieldValues(t.snapshotValues())
+}
+
+func (t *GenericSafetyQueue[T]) Backward() iter.Seq[T] {
+	return yieldValuesBackward(t.snapshotValues())
+}
+
+func (t *GenericSafetyQueue[T]) snapshotValues() (retValues []T) {
+	t.ExecuteReadMethod(func() {
+		if inst, ok := t.inst.(GenericPeekable[T]); ok {
+			retValues = inst.PeekValues(t.inst.GetLength())
+		}
+	})
+	return
+}
+
+// Drain takes the write lock for every value it pops and never holds it while
+// the loop body runs.
+func (t *GenericSafetyQueue[T]) Drain() iter.Seq[T] {
+	return drainValues(t.PopValue)
+}
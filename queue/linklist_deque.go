@@ -1,47 +1,135 @@
 package queue
 
-import (
-	"container/list"
-	"errors"
+const (
+	maxLinkListFreeNodeCount = 256
 )
 
+type LinkListNode[T any] struct {
+	next  *LinkListNode[T]
+	prev  *LinkListNode[T]
+	owner *GenericLinkListDeque[T]
+	// exposed marks a node handed out to a caller, it is never reused so a
+	// stale node cannot refer to a value pushed later
+	exposed bool
+	Value   T
+}
+
+// Next and Prev hand out the node they return as well, so it is marked exposed.
+func (t *LinkListNode[T]) Next() *LinkListNode[T] {
+	if t.owner == nil || t.next == &t.owner.root {
+		return nil
+	}
+	t.next.exposed = true
+	return t.next
+}
+
+func (t *LinkListNode[T]) Prev() *LinkListNode[T] {
+	if t.owner == nil || t.prev == &t.owner.root {
+		return nil
+	}
+	t.prev.exposed = true
+	return t.prev
+}
+
+type LinkListDeque = GenericLinkListDeque[any]
+
 func NewLinkListDeque(capacity int) *LinkListDeque {
-	return &LinkListDeque{
-		list:     list.New(),
+	return NewGenericLinkListDeque[any](capacity)
+}
+
+// GenericLinkListDeque is a doubly linked list deque with its own node type.
+// Removed nodes are kept in a small free list and reused by later pushes,
+// except the nodes handed out by ScanElementsFromFront, ScanElementsFromBack,
+// Next and Prev, so a node a caller holds is detached for good once its value
+// is removed and RemoveElements ignores it.
+type GenericLinkListDeque[T any] struct {
+	root          LinkListNode[T]
+	length        int
+	capacity      int
+	freeNodes     *LinkListNode[T]
+	freeNodeCount int
+}
+
+func NewGenericLinkListDeque[T any](capacity int) *GenericLinkListDeque[T] {
+	t := &GenericLinkListDeque[T]{
 		capacity: capacity,
 	}
+	t.root.next = &t.root
+	t.root.prev = &t.root
+	return t
 }
 
-type LinkListDeque struct {
-	list     *list.List
-	capacity int
+func (t *GenericLinkListDeque[T]) newNode(value T) *LinkListNode[T] {
+	node := t.freeNodes
+	if node == nil {
+		return &LinkListNode[T]{owner: t, Value: value}
+	}
+
+	t.freeNodes = node.next
+	t.freeNodeCount -= 1
+	node.next = nil
+	node.owner = t
+	node.Value = value
+	return node
+}
+
+func (t *GenericLinkListDeque[T]) releaseNode(node *LinkListNode[T]) {
+	var zero T
+	node.prev = nil
+	node.owner = nil
+	node.Value = zero
+	if node.exposed || t.freeNodeCount >= maxLinkListFreeNodeCount {
+		node.next = nil
+		return
+	}
+
+	node.next = t.freeNodes
+	t.freeNodes = node
+	t.freeNodeCount += 1
 }
 
-func (t *LinkListDeque) GetLength() int {
-	return t.list.Len()
+func (t *GenericLinkListDeque[T]) insertNode(node, at *LinkListNode[T]) {
+	node.prev = at
+	node.next = at.next
+	at.next.prev = node
+	at.next = node
+	t.length += 1
 }
 
-func (t *LinkListDeque) IsEmpty() bool {
-	return t.list.Len() == 0
+func (t *GenericLinkListDeque[T]) removeNode(node *LinkListNode[T]) T {
+	value := node.Value
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	t.length -= 1
+	t.releaseNode(node)
+	return value
 }
 
-func (t *LinkListDeque) IsFull() bool {
+func (t *GenericLinkListDeque[T]) GetLength() int {
+	return t.length
+}
+
+func (t *GenericLinkListDeque[T]) IsEmpty() bool {
+	return t.length == 0
+}
+
+func (t *GenericLinkListDeque[T]) IsFull() bool {
 	if t.capacity < 0 {
 		return false
 	}
 
-	return t.list.Len() >= t.capacity
+	return t.length >= t.capacity
 }
 
-func (t *LinkListDeque) GetAvailableCapacitySize() int {
+func (t *GenericLinkListDeque[T]) GetAvailableCapacitySize() int {
 	if t.capacity < 0 {
 		return -1
 	}
 
-	return t.capacity - t.list.Len()
+	return t.capacity - t.length
 }
 
-func (t *LinkListDeque) CheckAvailableCapacity(pushValueLen int) bool {
+func (t *GenericLinkListDeque[T]) CheckAvailableCapacity(pushValueLen int) bool {
 	availableCapSize := t.GetAvailableCapacitySize()
 	if availableCapSize < 0 {
 		return true
@@ -50,18 +138,28 @@ func (t *LinkListDeque) CheckAvailableCapacity(pushValueLen int) bool {
 	return availableCapSize >= pushValueLen
 }
 
-func (t *LinkListDeque) PushValueToBack(value any) error {
+// PushValue and PopValue make the queue a FIFO, they are the same as
+// PushValueToBack and PopValueFromFront.
+func (t *GenericLinkListDeque[T]) PushValue(value T) error {
+	return t.PushValueToBack(value)
+}
+
+func (t *GenericLinkListDeque[T]) PopValue() (T, bool) {
+	return t.PopValueFromFront()
+}
+
+func (t *GenericLinkListDeque[T]) PushValueToBack(value T) error {
 	if t.IsFull() {
-		return errors.New("the queue capacity is already full")
+		return ErrFull
 	}
 
-	t.list.PushBack(value)
+	t.insertNode(t.newNode(value), t.root.prev)
 	return nil
 }
 
-func (t *LinkListDeque) PushValuesToBack(values ...any) error {
+func (t *GenericLinkListDeque[T]) PushValuesToBack(values ...T) error {
 	if !t.CheckAvailableCapacity(len(values)) {
-		return errors.New("the capacity size of the queue is insufficient")
+		return newCapacityError(len(values), t.GetAvailableCapacitySize())
 	}
 
 	for _, value := range values {
@@ -73,7 +171,7 @@ func (t *LinkListDeque) PushValuesToBack(values ...any) error {
 	return nil
 }
 
-func (t *LinkListDeque) PushValuesToBackWithoutCheck(values ...any) (retPushedCount int) {
+func (t *GenericLinkListDeque[T]) PushValuesToBackWithoutCheck(values ...T) (retPushedCount int) {
 	for _, value := range values {
 		if err := t.PushValueToBack(value); err != nil {
 			return
@@ -84,18 +182,18 @@ func (t *LinkListDeque) PushValuesToBackWithoutCheck(values ...any) (retPushedCo
 	return
 }
 
-func (t *LinkListDeque) PushValueToFront(value any) error {
+func (t *GenericLinkListDeque[T]) PushValueToFront(value T) error {
 	if t.IsFull() {
-		return errors.New("the queue capacity is already full")
+		return ErrFull
 	}
 
-	t.list.PushFront(value)
+	t.insertNode(t.newNode(value), &t.root)
 	return nil
 }
 
-func (t *LinkListDeque) PushValuesToFront(values ...any) error {
+func (t *GenericLinkListDeque[T]) PushValuesToFront(values ...T) error {
 	if !t.CheckAvailableCapacity(len(values)) {
-		return errors.New("the capacity size of the queue is insufficient")
+		return newCapacityError(len(values), t.GetAvailableCapacitySize())
 	}
 
 	for _, value := range values {
@@ -107,7 +205,7 @@ func (t *LinkListDeque) PushValuesToFront(values ...any) error {
 	return nil
 }
 
-func (t *LinkListDeque) PushValuesToFrontWithoutCheck(values ...any) (retPushedCount int) {
+func (t *GenericLinkListDeque[T]) PushValuesToFrontWithoutCheck(values ...T) (retPushedCount int) {
 	for _, value := range values {
 		if err := t.PushValueToFront(value); err != nil {
 			return
@@ -118,19 +216,17 @@ func (t *LinkListDeque) PushValuesToFrontWithoutCheck(values ...any) (retPushedC
 	return
 }
 
-func (t *LinkListDeque) PopValueFromFront() (any, bool) {
-	valuesLen := t.list.Len()
-	if valuesLen <= 0 {
-		return nil, false
+func (t *GenericLinkListDeque[T]) PopValueFromFront() (T, bool) {
+	if t.length <= 0 {
+		var zero T
+		return zero, false
 	}
 
-	elem := t.list.Front()
-	t.list.Remove(elem)
-	return elem.Value, true
+	return t.removeNode(t.root.next), true
 }
 
-func (t *LinkListDeque) PopValuesFromFront(count int) (retValues []any) {
-	valuesLen := t.list.Len()
+func (t *GenericLinkListDeque[T]) PopValuesFromFront(count int) (retValues []T) {
+	valuesLen := t.length
 	if valuesLen <= 0 {
 		return
 	}
@@ -139,12 +235,12 @@ func (t *LinkListDeque) PopValuesFromFront(count int) (retValues []any) {
 	if valuesLen < count {
 		retValuesCap = valuesLen
 	}
-	retValues = make([]any, 0, retValuesCap)
+	retValues = make([]T, 0, retValuesCap)
 
 	for i := 0; i < count; i++ {
 		val, valid := t.PopValueFromFront()
 		if !valid {
-			continue
+			return
 		}
 		retValues = append(retValues, val)
 	}
@@ -152,49 +248,13 @@ func (t *LinkListDeque) PopValuesFromFront(count int) (retValues []any) {
 	return
 }
 
-func (t *LinkListDeque) PopValuesFromFrontToListSpace(ptrListSpace *[]any) (retCount int, retErr error) {
-	if ptrListSpace == nil {
-		retErr = errors.New("the parameter listSpace is a nil value")
-		return
-	}
-
-	listSpace := *ptrListSpace
-	listSpaceLen := len(listSpace)
-	if listSpaceLen > 0 {
-		for i := 0; i < listSpaceLen; i++ {
-			val, valid := t.PopValueFromFront()
-			if !valid {
-				return
-			}
-
-			listSpace[i] = val
-			retCount += 1
-		}
-		return
-	}
-
-	listSpaceCap := cap(listSpace)
-	if listSpaceCap <= 0 {
-		retErr = errors.New("the capacity of the parameter listSpace is 0")
-		return
-	}
-
-	for i := 0; i < listSpaceCap; i++ {
-		val, valid := t.PopValueFromFront()
-		if !valid {
-			return
-		}
-
-		*ptrListSpace = append(*ptrListSpace, val)
-		retCount += 1
-	}
-
-	return
+func (t *GenericLinkListDeque[T]) PopValuesFromFrontToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValueFromFront)
 }
 
-func (t *LinkListDeque) PopValuesFromFrontWithFilterFunction(f func(value interface{}) bool) (retErr error) {
+func (t *GenericLinkListDeque[T]) PopValuesFromFrontWithFilterFunction(f func(value T) bool) (retErr error) {
 	if f == nil {
-		return errors.New("the parameter f is a nil value")
+		return ErrNilFunc
 	}
 
 	for {
@@ -206,22 +266,19 @@ func (t *LinkListDeque) PopValuesFromFrontWithFilterFunction(f func(value interf
 			return
 		}
 	}
-	return
 }
 
-func (t *LinkListDeque) PopValueFromBack() (any, bool) {
-	valuesLen := t.list.Len()
-	if valuesLen <= 0 {
-		return nil, false
+func (t *GenericLinkListDeque[T]) PopValueFromBack() (T, bool) {
+	if t.length <= 0 {
+		var zero T
+		return zero, false
 	}
 
-	elem := t.list.Back()
-	t.list.Remove(elem)
-	return elem.Value, true
+	return t.removeNode(t.root.prev), true
 }
 
-func (t *LinkListDeque) PopValuesFromBack(count int) (retValues []any) {
-	valuesLen := t.list.Len()
+func (t *GenericLinkListDeque[T]) PopValuesFromBack(count int) (retValues []T) {
+	valuesLen := t.length
 	if valuesLen <= 0 {
 		return
 	}
@@ -230,12 +287,12 @@ func (t *LinkListDeque) PopValuesFromBack(count int) (retValues []any) {
 	if valuesLen < count {
 		retValuesCap = valuesLen
 	}
-	retValues = make([]any, 0, retValuesCap)
+	retValues = make([]T, 0, retValuesCap)
 
 	for i := 0; i < count; i++ {
 		val, valid := t.PopValueFromBack()
 		if !valid {
-			continue
+			return
 		}
 		retValues = append(retValues, val)
 	}
@@ -243,81 +300,120 @@ func (t *LinkListDeque) PopValuesFromBack(count int) (retValues []any) {
 	return
 }
 
-func (t *LinkListDeque) PopValuesFromBackToListSpace(ptrListSpace *[]any) (retCount int, retErr error) {
-	if ptrListSpace == nil {
-		retErr = errors.New("the parameter listSpace is a nil value")
-		return
-	}
-
-	listSpace := *ptrListSpace
-	listSpaceLen := len(listSpace)
-	if listSpaceLen > 0 {
-		for i := 0; i < listSpaceLen; i++ {
-			val, valid := t.PopValueFromBack()
-			if !valid {
-				return
-			}
-
-			listSpace[i] = val
-			retCount += 1
-		}
-		return
-	}
+func (t *GenericLinkListDeque[T]) PopValuesFromBackToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValueFromBack)
+}
 
-	listSpaceCap := cap(listSpace)
-	if listSpaceCap <= 0 {
-		retErr = errors.New("the capacity of the parameter listSpace is 0")
-		return
+func (t *GenericLinkListDeque[T]) PopValuesFromBackWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
 	}
 
-	for i := 0; i < listSpaceCap; i++ {
-		val, valid := t.PopValueFromFront()
+	for {
+		value, valid := t.PopValueFromBack()
 		if !valid {
 			return
 		}
+		if !f(value) {
+			return
+		}
+	}
+}
 
-		*ptrListSpace = append(*ptrListSpace, val)
-		retCount += 1
+func (t *GenericLinkListDeque[T]) PeekValue() (T, bool) {
+	return t.PeekFront()
+}
+
+func (t *GenericLinkListDeque[T]) PeekValues(count int) (retValues []T) {
+	for node := t.root.next; node != &t.root && len(retValues) < count; node = node.next {
+		retValues = append(retValues, node.Value)
 	}
 
 	return
 }
 
-func (t *LinkListDeque) PopValuesFromBackWithFilterFunction(f func(value interface{}) bool) (retErr error) {
-	if f == nil {
-		return errors.New("the parameter f is a nil value")
+func (t *GenericLinkListDeque[T]) PeekFront() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericLinkListDeque[T]) PeekBack() (T, bool) {
+	return t.PeekAt(t.length - 1)
+}
+
+func (t *GenericLinkListDeque[T]) PeekAt(index int) (T, bool) {
+	if index < 0 || index >= t.length {
+		var zero T
+		return zero, false
 	}
 
-	for {
-		value, valid := t.PopValueFromBack()
-		if !valid {
-			return
+	node := t.root.next
+	if index < t.length/2 {
+		for i := 0; i < index; i++ {
+			node = node.next
 		}
-		if !f(value) {
-			return
+	} else {
+		node = t.root.prev
+		for i := t.length - 1; i > index; i-- {
+			node = node.prev
 		}
 	}
-	return
+	return node.Value, true
 }
 
-func (t *LinkListDeque) ScanElementsFromFront(f func(elem *list.Element) bool) {
-	for elem := t.list.Front(); elem != nil; elem = elem.Next() {
-		if !f(elem) {
+func (t *GenericLinkListDeque[T]) ScanElementsFromFront(f func(node *LinkListNode[T]) bool) {
+	t.scanNodesFromFront(func(node *LinkListNode[T]) bool {
+		node.exposed = true
+		return f(node)
+	})
+}
+
+func (t *GenericLinkListDeque[T]) ScanElementsFromBack(f func(node *LinkListNode[T]) bool) {
+	t.scanNodesFromBack(func(node *LinkListNode[T]) bool {
+		node.exposed = true
+		return f(node)
+	})
+}
+
+// The scans look up the following node only after f returns, so f may remove
+// the node it is given and any other node, except that it must not remove both
+// the node and the one visited before it, the scan stops in that case.
+func (t *GenericLinkListDeque[T]) scanNodesFromFront(f func(node *LinkListNode[T]) bool) {
+	for node := t.root.next; node != &t.root; {
+		prev := node.prev
+		if !f(node) {
 			return
 		}
+		if node.owner != t {
+			if prev != &t.root && prev.owner != t {
+				return
+			}
+			node = prev
+		}
+		node = node.next
 	}
 }
 
-func (t *LinkListDeque) ScanElementsFromBack(f func(elem *list.Element) bool) {
-	for elem := t.list.Back(); elem != nil; elem = elem.Prev() {
-		if !f(elem) {
+func (t *GenericLinkListDeque[T]) scanNodesFromBack(f func(node *LinkListNode[T]) bool) {
+	for node := t.root.prev; node != &t.root; {
+		next := node.next
+		if !f(node) {
 			return
 		}
+		if node.owner != t {
+			if next != &t.root && next.owner != t {
+				return
+			}
+			node = next
+		}
+		node = node.prev
 	}
 }
 
-func (t *LinkListDeque) RemoveElements(elems []*list.Element) {
-	for _, elem := range elems {
-		t.list.Remove(elem)
+func (t *GenericLinkListDeque[T]) RemoveElements(nodes []*LinkListNode[T]) {
+	for _, node := range nodes {
+		if node == nil || node.owner != t {
+			continue
+		}
+		t.removeNode(node)
 	}
 }
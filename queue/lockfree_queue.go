@@ -0,0 +1,151 @@
+package queue
+
+import (
+	"sync/atomic"
+)
+
+type LockFreeQueue = GenericLockFreeQueue[any]
+
+func NewLockFreeQueue() *LockFreeQueue {
+	return NewGenericLockFreeQueue[any]()
+}
+
+type lockFreeNode[T any] struct {
+	next  atomic.Pointer[lockFreeNode[T]]
+	value T
+}
+
+// GenericLockFreeQueue is an unbounded Michael-Scott FIFO queue that any number
+// of goroutines can push to the back and pop from the front of without locks.
+// Nodes are never reused, the garbage collector reclaims them once no
+// goroutine can reach them, which rules out the ABA problem.
+type GenericLockFreeQueue[T any] struct {
+	_      cacheLinePad
+	head   atomic.Pointer[lockFreeNode[T]]
+	_      cacheLinePad
+	tail   atomic.Pointer[lockFreeNode[T]]
+	_      cacheLinePad
+	length atomic.Int64
+}
+
+func NewGenericLockFreeQueue[T any]() *GenericLockFreeQueue[T] {
+	t := &GenericLockFreeQueue[T]{}
+	dummy := &lockFreeNode[T]{}
+	t.head.Store(dummy)
+	t.tail.Store(dummy)
+	return t
+}
+
+// GetLength is a snapshot that may already be stale when it returns.
+func (t *GenericLockFreeQueue[T]) GetLength() int {
+	length := t.length.Load()
+	if length < 0 {
+		return 0
+	}
+	return int(length)
+}
+
+func (t *GenericLockFreeQueue[T]) IsEmpty() bool {
+	return t.head.Load().next.Load() == nil
+}
+
+// PushValue and PopValue make the queue a FIFO, they are the same as
+// PushValueToBack and PopValueFromFront.
+func (t *GenericLockFreeQueue[T]) PushValue(value T) error {
+	return t.PushValueToBack(value)
+}
+
+func (t *GenericLockFreeQueue[T]) PopValue() (T, bool) {
+	return t.PopValueFromFront()
+}
+
+func (t *GenericLockFreeQueue[T]) PushValueToBack(value T) error {
+	node := &lockFreeNode[T]{value: value}
+	for {
+		tail := t.tail.Load()
+		next := tail.next.Load()
+		if tail != t.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			t.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			t.tail.CompareAndSwap(tail, node)
+			t.length.Add(1)
+			return nil
+		}
+	}
+}
+
+func (t *GenericLockFreeQueue[T]) PushValuesToBack(values ...T) error {
+	for _, value := range values {
+		if err := t.PushValueToBack(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericLockFreeQueue[T]) PopValueFromFront() (T, bool) {
+	var zero T
+	for {
+		head := t.head.Load()
+		tail := t.tail.Load()
+		next := head.next.Load()
+		if head != t.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			return zero, false
+		}
+		if head == tail {
+			t.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if t.head.CompareAndSwap(head, next) {
+			// Only the goroutine that moved the head touches the value of the
+			// new dummy node, so it can be cleared for the garbage collector.
+			retValue := next.value
+			next.value = zero
+			t.length.Add(-1)
+			return retValue, true
+		}
+	}
+}
+
+func (t *GenericLockFreeQueue[T]) PopValuesFromFront(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValueFromFront()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericLockFreeQueue[T]) PopValuesFromFrontToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValueFromFront)
+}
+
+func (t *GenericLockFreeQueue[T]) PopValuesFromFrontWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValueFromFront()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
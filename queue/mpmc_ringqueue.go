@@ -0,0 +1,187 @@
+package queue
+
+import (
+	"sync/atomic"
+)
+
+type MPMCRingQueue = GenericMPMCRingQueue[any]
+
+func NewMPMCRingQueue(capacity int) *MPMCRingQueue {
+	return NewGenericMPMCRingQueue[any](capacity)
+}
+
+type mpmcSlot[T any] struct {
+	sequence atomic.Uint64
+	value    T
+}
+
+// GenericMPMCRingQueue is a bounded lock-free queue for any number of
+// producers and consumers, every slot carries a sequence number telling which
+// lap of the ring it is ready for. The capacity is rounded up to a power of two.
+//
+// Batch operations are not atomic with respect to other goroutines: PushValues
+// reserves all its slots at once, but consumers may observe the values one by
+// one, and the batch pops take values one at a time so they can interleave
+// with other consumers.
+//
+// A value can not be observed without claiming its slot, so the queue does not
+// implement GenericPeekable, GenericIndexable or GenericScannable.
+type GenericMPMCRingQueue[T any] struct {
+	_        cacheLinePad
+	tail     paddedUint64
+	head     paddedUint64
+	capacity uint64
+	mask     uint64
+	slots    []mpmcSlot[T]
+}
+
+func NewGenericMPMCRingQueue[T any](capacity int) *GenericMPMCRingQueue[T] {
+	size := roundUpPowerOfTwo(capacity)
+	t := &GenericMPMCRingQueue[T]{
+		capacity: uint64(size),
+		mask:     uint64(size - 1),
+		slots:    make([]mpmcSlot[T], size),
+	}
+	for i := range t.slots {
+		t.slots[i].sequence.Store(uint64(i))
+	}
+	return t
+}
+
+func (t *GenericMPMCRingQueue[T]) GetLength() int {
+	head := t.head.Load()
+	tail := t.tail.Load()
+	length := tail - head
+	if length > t.capacity {
+		length = t.capacity
+	}
+	return int(length)
+}
+
+func (t *GenericMPMCRingQueue[T]) IsEmpty() bool {
+	return t.GetLength() == 0
+}
+
+func (t *GenericMPMCRingQueue[T]) IsFull() bool {
+	return t.GetLength() >= int(t.capacity)
+}
+
+func (t *GenericMPMCRingQueue[T]) GetAvailableCapacitySize() int {
+	return int(t.capacity) - t.GetLength()
+}
+
+func (t *GenericMPMCRingQueue[T]) PushValue(value T) error {
+	pos := t.tail.Load()
+	for {
+		slot := &t.slots[pos&t.mask]
+		diff := int64(slot.sequence.Load() - pos)
+		if diff == 0 {
+			if t.tail.CompareAndSwap(pos, pos+1) {
+				slot.value = value
+				slot.sequence.Store(pos + 1)
+				return nil
+			}
+		} else if diff < 0 {
+			return ErrFull
+		}
+		pos = t.tail.Load()
+	}
+}
+
+func (t *GenericMPMCRingQueue[T]) PushValues(values ...T) error {
+	valuesLen := uint64(len(values))
+	if valuesLen <= 0 {
+		return nil
+	}
+	if valuesLen > t.capacity {
+		return newCapacityError(len(values), t.GetAvailableCapacitySize())
+	}
+
+	for {
+		pos := t.tail.Load()
+		reservable := true
+		for i := uint64(0); i < valuesLen; i++ {
+			diff := int64(t.slots[(pos+i)&t.mask].sequence.Load() - (pos + i))
+			if diff < 0 {
+				return newCapacityError(len(values), int(i))
+			}
+			if diff > 0 {
+				reservable = false
+				break
+			}
+		}
+		if !reservable || !t.tail.CompareAndSwap(pos, pos+valuesLen) {
+			continue
+		}
+
+		for i, value := range values {
+			slot := &t.slots[(pos+uint64(i))&t.mask]
+			slot.value = value
+			slot.sequence.Store(pos + uint64(i) + 1)
+		}
+		return nil
+	}
+}
+
+func (t *GenericMPMCRingQueue[T]) PushValuesWithoutCheck(values ...T) (retPushedCount int) {
+	for _, value := range values {
+		if err := t.PushValue(value); err != nil {
+			return
+		}
+		retPushedCount += 1
+	}
+
+	return
+}
+
+func (t *GenericMPMCRingQueue[T]) PopValue() (T, bool) {
+	var zero T
+	pos := t.head.Load()
+	for {
+		slot := &t.slots[pos&t.mask]
+		diff := int64(slot.sequence.Load() - (pos + 1))
+		if diff == 0 {
+			if t.head.CompareAndSwap(pos, pos+1) {
+				retValue := slot.value
+				slot.value = zero
+				slot.sequence.Store(pos + t.mask + 1)
+				return retValue, true
+			}
+		} else if diff < 0 {
+			return zero, false
+		}
+		pos = t.head.Load()
+	}
+}
+
+func (t *GenericMPMCRingQueue[T]) PopValues(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericMPMCRingQueue[T]) PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValue)
+}
+
+func (t *GenericMPMCRingQueue[T]) PopValuesWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package queue
+
+import (
+	"context"
+)
+
+// waitNotifier wakes up the goroutines waiting for a state change of a queue.
+// The channel is only created when someone waits, so notifying without
+// waiters costs nothing. It must be used while holding the owner's write lock.
+type waitNotifier struct {
+	ch chan struct{}
+}
+
+func (t *waitNotifier) waitChan() <-chan struct{} {
+	if t.ch == nil {
+		t.ch = make(chan struct{})
+	}
+	return t.ch
+}
+
+func (t *waitNotifier) notify() {
+	if t.ch == nil {
+		return
+	}
+
+	close(t.ch)
+	t.ch = nil
+}
+
+func waitNotification(ctx context.Context, ch <-chan struct{}) error {
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// closeState tracks the closed flag of a queue and the channel that is closed
+// once the queue has been closed and drained. It must be used while holding
+// the owner's write lock, except for doneChan.
+type closeState struct {
+	closed bool
+	doneCh chan struct{}
+}
+
+func newCloseState() closeState {
+	return closeState{
+		doneCh: make(chan struct{}),
+	}
+}
+
+func (t *closeState) close() error {
+	if t.closed {
+		return ErrClosed
+	}
+
+	t.closed = true
+	return nil
+}
+
+func (t *closeState) checkDrained(isEmpty bool) {
+	if !t.closed || !isEmpty {
+		return
+	}
+
+	select {
+	case <-t.doneCh:
+	default:
+		close(t.doneCh)
+	}
+}
+
+func (t *closeState) doneChan() <-chan struct{} {
+	return t.doneCh
+}
@@ -0,0 +1,20 @@
+package queue
+
+import (
+	"sync/atomic"
+)
+
+const (
+	cacheLineSize = 64
+)
+
+type cacheLinePad struct {
+	_ [cacheLineSize]byte
+}
+
+// paddedUint64 keeps an index on its own cache line so that the producer and
+// the consumer do not invalidate each other's line on every update.
+type paddedUint64 struct {
+	atomic.Uint64
+	_ [cacheLineSize - 8]byte
+}
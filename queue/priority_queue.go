@@ -0,0 +1,345 @@
+package queue
+
+type PriorityQueue = GenericPriorityQueue[any]
+
+func NewPriorityQueue(capacity int, lessFunc func(a, b any) bool) (*PriorityQueue, error) {
+	return NewGenericPriorityQueue[any](capacity, lessFunc)
+}
+
+// GenericPriorityQueue is a binary heap that pops the value for which lessFunc
+// reports true against every other value first. Values of equal priority are
+// popped in no particular order. A negative capacity means unbounded.
+type GenericPriorityQueue[T any] struct {
+	capacity int
+	lessFunc func(a, b T) bool
+	values   []T
+}
+
+func NewGenericPriorityQueue[T any](capacity int, lessFunc func(a, b T) bool) (*GenericPriorityQueue[T], error) {
+	if lessFunc == nil {
+		return nil, ErrNilFunc
+	}
+	return newGenericPriorityQueue[T](capacity, lessFunc), nil
+}
+
+func newGenericPriorityQueue[T any](capacity int, lessFunc func(a, b T) bool) *GenericPriorityQueue[T] {
+	t := &GenericPriorityQueue[T]{
+		capacity: capacity,
+		lessFunc: lessFunc,
+	}
+	if capacity > 0 {
+		t.values = make([]T, 0, capacity)
+	}
+	return t
+}
+
+func (t *GenericPriorityQueue[T]) up(idx int) {
+	for idx > 0 {
+		parent := (idx - 1) / 2
+		if !t.lessFunc(t.values[idx], t.values[parent]) {
+			return
+		}
+		t.values[idx], t.values[parent] = t.values[parent], t.values[idx]
+		idx = parent
+	}
+}
+
+func (t *GenericPriorityQueue[T]) down(idx int) {
+	valuesLen := len(t.values)
+	for {
+		smallest := idx
+		left := 2*idx + 1
+		right := left + 1
+		if left < valuesLen && t.lessFunc(t.values[left], t.values[smallest]) {
+			smallest = left
+		}
+		if right < valuesLen && t.lessFunc(t.values[right], t.values[smallest]) {
+			smallest = right
+		}
+		if smallest == idx {
+			return
+		}
+		t.values[idx], t.values[smallest] = t.values[smallest], t.values[idx]
+		idx = smallest
+	}
+}
+
+func (t *GenericPriorityQueue[T]) GetLength() int {
+	return len(t.values)
+}
+
+func (t *GenericPriorityQueue[T]) IsEmpty() bool {
+	return len(t.values) == 0
+}
+
+func (t *GenericPriorityQueue[T]) IsFull() bool {
+	if t.capacity < 0 {
+		return false
+	}
+
+	return len(t.values) >= t.capacity
+}
+
+func (t *GenericPriorityQueue[T]) GetAvailableCapacitySize() int {
+	if t.capacity < 0 {
+		return -1
+	}
+
+	return t.capacity - len(t.values)
+}
+
+func (t *GenericPriorityQueue[T]) CheckAvailableCapacity(pushValueLen int) bool {
+	availableCapSize := t.GetAvailableCapacitySize()
+	if availableCapSize < 0 {
+		return true
+	}
+
+	return availableCapSize >= pushValueLen
+}
+
+func (t *GenericPriorityQueue[T]) PushValue(value T) error {
+	if t.IsFull() {
+		return ErrFull
+	}
+
+	t.values = append(t.values, value)
+	t.up(len(t.values) - 1)
+	return nil
+}
+
+func (t *GenericPriorityQueue[T]) PushValues(values ...T) error {
+	if !t.CheckAvailableCapacity(len(values)) {
+		return newCapacityError(len(values), t.GetAvailableCapacitySize())
+	}
+
+	for _, value := range values {
+		if err := t.PushValue(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericPriorityQueue[T]) PushValuesWithoutCheck(values ...T) (retPushedCount int) {
+	for _, value := range values {
+		if err := t.PushValue(value); err != nil {
+			return
+		}
+		retPushedCount += 1
+	}
+
+	return
+}
+
+// scanInOrder visits the heap indexes of the values in pop order without
+// modifying the heap, a value can only be reached after its parent so it keeps
+// the next candidates in a second heap of indexes.
+func (t *GenericPriorityQueue[T]) scanInOrder(f func(idx int) bool) {
+	if len(t.values) <= 0 {
+		return
+	}
+
+	candidates := newGenericPriorityQueue[int](-1, func(a, b int) bool {
+		return t.lessFunc(t.values[a], t.values[b])
+	})
+	_ = candidates.PushValue(0)
+	for {
+		idx, ok := candidates.PopValue()
+		if !ok || !f(idx) {
+			return
+		}
+		for child := 2*idx + 1; child <= 2*idx+2 && child < len(t.values); child++ {
+			_ = candidates.PushValue(child)
+		}
+	}
+}
+
+func (t *GenericPriorityQueue[T]) PeekValue() (T, bool) {
+	if len(t.values) <= 0 {
+		var zero T
+		return zero, false
+	}
+
+	return t.values[0], true
+}
+
+func (t *GenericPriorityQueue[T]) PeekValues(count int) (retValues []T) {
+	if count <= 0 {
+		return
+	}
+
+	t.scanInOrder(func(idx int) bool {
+		retValues = append(retValues, t.values[idx])
+		return len(retValues) < count
+	})
+	return
+}
+
+func (t *GenericPriorityQueue[T]) PeekFront() (T, bool) {
+	return t.PeekValue()
+}
+
+// PeekBack returns the value that would be popped last, it is one of the
+// leaves of the heap.
+func (t *GenericPriorityQueue[T]) PeekBack() (T, bool) {
+	var retValue T
+	valuesLen := len(t.values)
+	if valuesLen <= 0 {
+		return retValue, false
+	}
+
+	retValue = t.values[valuesLen/2]
+	for _, value := range t.values[valuesLen/2+1:] {
+		if t.lessFunc(retValue, value) {
+			retValue = value
+		}
+	}
+	return retValue, true
+}
+
+// PeekAt returns the value that would be popped after index other values.
+func (t *GenericPriorityQueue[T]) PeekAt(index int) (T, bool) {
+	var zero T
+	heapIdx := t.heapIndex(index)
+	if heapIdx < 0 {
+		return zero, false
+	}
+
+	return t.values[heapIdx], true
+}
+
+func (t *GenericPriorityQueue[T]) heapIndex(index int) (retHeapIdx int) {
+	retHeapIdx = -1
+	if index < 0 || index >= len(t.values) {
+		return
+	}
+
+	t.scanInOrder(func(idx int) bool {
+		if index > 0 {
+			index -= 1
+			return true
+		}
+		retHeapIdx = idx
+		return false
+	})
+	return
+}
+
+func (t *GenericPriorityQueue[T]) Get(index int) (T, error) {
+	value, ok := t.PeekAt(index)
+	if !ok {
+		return value, newIndexError(index, len(t.values))
+	}
+
+	return value, nil
+}
+
+func (t *GenericPriorityQueue[T]) RemoveAt(index int) (T, error) {
+	var zero T
+	heapIdx := t.heapIndex(index)
+	if heapIdx < 0 {
+		return zero, newIndexError(index, len(t.values))
+	}
+
+	retValue := t.values[heapIdx]
+	lastIdx := len(t.values) - 1
+	t.values[heapIdx] = t.values[lastIdx]
+	t.values[lastIdx] = zero
+	t.values = t.values[:lastIdx]
+	if heapIdx < lastIdx {
+		t.up(heapIdx)
+		t.down(heapIdx)
+	}
+	return retValue, nil
+}
+
+// ScanElements visits the values in pop order until f returns false.
+func (t *GenericPriorityQueue[T]) ScanElements(f func(value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	t.scanInOrder(func(idx int) bool {
+		return f(t.values[idx])
+	})
+	return nil
+}
+
+func (t *GenericPriorityQueue[T]) ScanElementsReverse(f func(value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	heapIndexes := make([]int, 0, len(t.values))
+	t.scanInOrder(func(idx int) bool {
+		heapIndexes = append(heapIndexes, idx)
+		return true
+	})
+	for i := len(heapIndexes) - 1; i >= 0; i-- {
+		if !f(t.values[heapIndexes[i]]) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (t *GenericPriorityQueue[T]) ScanElementsWithIndex(f func(index int, value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	var index int
+	t.scanInOrder(func(idx int) bool {
+		index += 1
+		return f(index-1, t.values[idx])
+	})
+	return nil
+}
+
+func (t *GenericPriorityQueue[T]) PopValue() (T, bool) {
+	var zero T
+	lastIdx := len(t.values) - 1
+	if lastIdx < 0 {
+		return zero, false
+	}
+
+	retValue := t.values[0]
+	t.values[0] = t.values[lastIdx]
+	t.values[lastIdx] = zero
+	t.values = t.values[:lastIdx]
+	t.down(0)
+	return retValue, true
+}
+
+func (t *GenericPriorityQueue[T]) PopValues(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericPriorityQueue[T]) PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValue)
+}
+
+func (t *GenericPriorityQueue[T]) PopValuesWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package queuetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/akley-MK4/go-data-structure/queue"
+)
+
+// RunDequeConformance checks that the deques created by newDequeFunc behave
+// like queue.LinkListDeque. Every case creates a new deque, which must be
+// empty, with the t of the case, so newDequeFunc can fail it with t.Fatal.
+func RunDequeConformance(t *testing.T, newDequeFunc func(t *testing.T) queue.IDeque, opts ...Option) {
+	c := newConfig(opts)
+
+	newDeque := func(t *testing.T) queue.IDeque {
+		t.Helper()
+		d := newDequeFunc(t)
+		if d == nil {
+			t.Fatal("Failed to create a deque, the instance is nil")
+		}
+		if !d.IsEmpty() || d.GetLength() != 0 {
+			t.Fatal("Failed to create a deque, the instance is not empty")
+		}
+		return d
+	}
+
+	t.Run("Capacity", func(t *testing.T) {
+		d := newDeque(t)
+		availableCapSize := d.GetAvailableCapacitySize()
+		if availableCapSize < 0 {
+			if d.IsFull() || !d.CheckAvailableCapacity(maxElemValuesLen) {
+				t.Error("An unbounded deque is reported full")
+			}
+			return
+		}
+		if availableCapSize == 0 {
+			t.Skip("The deque has no capacity to test")
+		}
+
+		for i := 0; i < availableCapSize; i++ {
+			pushFunc := d.PushValueToBack
+			if i%2 == 1 {
+				pushFunc = d.PushValueToFront
+			}
+			if err := pushFunc(i); err != nil {
+				t.Errorf("Failed to push the value to the deque, %v", err)
+				return
+			}
+		}
+		if !d.IsFull() || d.GetAvailableCapacitySize() != 0 || d.CheckAvailableCapacity(1) {
+			t.Error("The deque is expected to be full")
+			return
+		}
+		if err := d.PushValueToBack(-1); !errors.Is(err, queue.ErrFull) {
+			t.Errorf("Failed to reject a push to the back of a full deque, %v", err)
+			return
+		}
+		if err := d.PushValueToFront(-1); !errors.Is(err, queue.ErrFull) {
+			t.Errorf("Failed to reject a push to the front of a full deque, %v", err)
+			return
+		}
+		checkCapacityError(t, d.PushValuesToBack(-1), 1, 0)
+		checkCapacityError(t, d.PushValuesToFront(-1), 1, 0)
+
+		if _, ok := d.PopValueFromBack(); !ok {
+			t.Error("Failed to pop a value from a full deque")
+			return
+		}
+		if d.IsFull() || !d.CheckAvailableCapacity(1) || d.CheckAvailableCapacity(2) {
+			t.Error("The deque is expected to have room for one value")
+			return
+		}
+		checkCapacityError(t, d.PushValuesToFront(-1, -1), 2, 1)
+		if d.GetLength() != availableCapSize-1 {
+			t.Error("A rejected batch push changed the length of the deque")
+			return
+		}
+	})
+
+	t.Run("Ordering", func(t *testing.T) {
+		d := newDeque(t)
+		elemValues := newElemValues(elemValuesLen(d.GetAvailableCapacitySize()))
+		cases := []struct {
+			name      string
+			pushFunc  func(values ...any) error
+			popFunc   func() (any, bool)
+			popValues []any
+		}{
+			{"BackToFront", d.PushValuesToBack, d.PopValueFromFront, elemValues},
+			{"BackToBack", d.PushValuesToBack, d.PopValueFromBack, reverseValues(elemValues)},
+			{"FrontToBack", d.PushValuesToFront, d.PopValueFromBack, elemValues},
+			{"FrontToFront", d.PushValuesToFront, d.PopValueFromFront, reverseValues(elemValues)},
+		}
+
+		for _, cs := range cases {
+			if err := cs.pushFunc(elemValues...); err != nil {
+				t.Errorf("%s: failed to push values to the deque, %v", cs.name, err)
+				return
+			}
+			if d.GetLength() != len(elemValues) {
+				t.Errorf("%s: wrong number of elements", cs.name)
+				return
+			}
+
+			var poppedValues []any
+			for range elemValues {
+				value, ok := cs.popFunc()
+				if !ok {
+					t.Errorf("%s: failed to pop a value from the deque", cs.name)
+					return
+				}
+				poppedValues = append(poppedValues, value)
+			}
+			checkPoppedValues(t, poppedValues, cs.popValues)
+			if _, ok := cs.popFunc(); ok || !d.IsEmpty() {
+				t.Errorf("%s: the deque is expected to be empty", cs.name)
+				return
+			}
+		}
+
+		if len(elemValues) < 2 {
+			return
+		}
+
+		// Move the ends around the buffer, then push to both of them
+		for _, value := range elemValues {
+			if err := d.PushValueToBack(value); err != nil {
+				t.Errorf("Failed to push the value to the deque, %v", err)
+				return
+			}
+			if _, ok := d.PopValueFromFront(); !ok {
+				t.Error("Failed to pop a value from the deque")
+				return
+			}
+		}
+		if err := d.PushValueToBack(0); err != nil {
+			t.Errorf("Failed to push the value to the deque, %v", err)
+			return
+		}
+		if err := d.PushValueToFront(-1); err != nil {
+			t.Errorf("Failed to push the value to the deque, %v", err)
+			return
+		}
+		if value, ok := d.PopValueFromBack(); !ok || value != 0 {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+		if value, ok := d.PopValueFromBack(); !ok || value != -1 {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	})
+
+	t.Run("BatchPop", func(t *testing.T) {
+		d := newDeque(t)
+		if len(d.PopValuesFromFront(1)) != 0 || len(d.PopValuesFromBack(1)) != 0 {
+			t.Error("Popped values from an empty deque")
+			return
+		}
+
+		elemValues := newElemValues(elemValuesLen(d.GetAvailableCapacitySize()))
+		if err := d.PushValuesToBack(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		onceLen := len(elemValues) / 4
+		checkPoppedValues(t, d.PopValuesFromFront(onceLen), elemValues[:onceLen])
+		checkPoppedValues(t, d.PopValuesFromBack(onceLen), reverseValues(elemValues[len(elemValues)-onceLen:]))
+		checkPoppedValues(t, d.PopValuesFromFront(len(elemValues)), elemValues[onceLen:len(elemValues)-onceLen])
+		if !d.IsEmpty() {
+			t.Error("The deque is expected to be empty")
+			return
+		}
+	})
+
+	t.Run("ListSpacePop", func(t *testing.T) {
+		d := newDeque(t)
+		elemValues := newElemValues(elemValuesLen(d.GetAvailableCapacitySize()))
+		if err := d.PushValuesToBack(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		checkListSpacePop(t, elemValues, d.PopValuesFromFrontToListSpace)
+
+		if err := d.PushValuesToBack(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		checkListSpacePop(t, reverseValues(elemValues), d.PopValuesFromBackToListSpace)
+	})
+
+	t.Run("FilterPop", func(t *testing.T) {
+		d := newDeque(t)
+		elemValues := newElemValues(elemValuesLen(d.GetAvailableCapacitySize()))
+		if err := d.PushValuesToBack(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		checkFilterPop(t, elemValues, d.PopValuesFromFrontWithFilterFunction, d.PopValueFromFront)
+
+		if err := d.PushValuesToBack(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		checkFilterPop(t, reverseValues(elemValues), d.PopValuesFromBackWithFilterFunction, d.PopValueFromBack)
+	})
+
+	t.Run("Peek", func(t *testing.T) {
+		d := newDeque(t)
+		p, ok := d.(queue.Peekable)
+		if !ok {
+			t.Skip("The deque does not implement queue.Peekable")
+		}
+		checkPeekEmpty(t, p)
+
+		// Push half of the values to the front, so they wrap around the
+		// start of a ring buffer
+		elemValues := newElemValues(elemValuesLen(d.GetAvailableCapacitySize()))
+		halfLen := len(elemValues) / 2
+		if err := d.PushValuesToFront(reverseValues(elemValues[:halfLen])...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		if err := d.PushValuesToBack(elemValues[halfLen:]...); err != nil {
+			t.Errorf("Failed to push values to the deque, %v", err)
+			return
+		}
+		checkPeekValues(t, p, elemValues)
+		if d.GetLength() != len(elemValues) {
+			t.Error("Peeking removed values from the deque")
+			return
+		}
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		if c.producers <= 0 || c.consumers <= 0 {
+			t.Skip("The concurrency case is not enabled")
+		}
+		d := newDeque(t)
+		checkConcurrency(t, c, d.PushValueToBack, d.PopValueFromFront)
+	})
+}
@@ -0,0 +1,378 @@
+// Package queuetest provides conformance suites that check an implementation
+// of queue.IRingQueue or queue.IDeque against the behaviour of the queues in
+// package queue, for the built-in backends as well as custom ones.
+package queuetest
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/akley-MK4/go-data-structure/queue"
+)
+
+const (
+	// maxElemValuesLen limits the number of values pushed by a case, so large
+	// or unbounded queues are tested with a bounded amount of work.
+	maxElemValuesLen = 64
+
+	concurrentValuesLenPerProducer = 2000
+)
+
+type config struct {
+	producers int
+	consumers int
+}
+
+type Option func(c *config)
+
+// WithConcurrency enables the concurrency case with the given number of
+// producer and consumer goroutines. Only pass it for implementations that are
+// safe for that many concurrent producers and consumers.
+func WithConcurrency(producers, consumers int) Option {
+	return func(c *config) {
+		c.producers = producers
+		c.consumers = consumers
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func elemValuesLen(availableCapSize int) int {
+	if availableCapSize < 0 || availableCapSize > maxElemValuesLen {
+		return maxElemValuesLen
+	}
+	return availableCapSize
+}
+
+func newElemValues(valuesLen int) []any {
+	values := make([]any, 0, valuesLen)
+	for i := 0; i < valuesLen; i++ {
+		values = append(values, i)
+	}
+	return values
+}
+
+func checkCapacityError(t *testing.T, err error, requested, available int) {
+	t.Helper()
+
+	if !errors.Is(err, queue.ErrInsufficientCapacity) {
+		t.Errorf("Failed to reject pushing beyond the capacity, %v", err)
+		return
+	}
+	var capErr *queue.CapacityError
+	if !errors.As(err, &capErr) || capErr.Requested != requested || capErr.Available != available {
+		t.Errorf("The capacity error does not match the result, %v", err)
+	}
+}
+
+func checkPoppedValues(t *testing.T, poppedValues []any, elemValues []any) {
+	t.Helper()
+
+	if len(poppedValues) != len(elemValues) {
+		t.Errorf("Wrong number of popped values, %d != %d", len(poppedValues), len(elemValues))
+		return
+	}
+	for idx, v := range poppedValues {
+		if v != elemValues[idx] {
+			t.Errorf("The pop-up value does not match the result at %d, %v != %v", idx, v, elemValues[idx])
+			return
+		}
+	}
+}
+
+func reverseValues(values []any) []any {
+	reversed := make([]any, 0, len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		reversed = append(reversed, values[i])
+	}
+	return reversed
+}
+
+func checkPeekEmpty(t *testing.T, p queue.Peekable) {
+	t.Helper()
+
+	_, valueOk := p.PeekValue()
+	_, frontOk := p.PeekFront()
+	_, backOk := p.PeekBack()
+	_, atOk := p.PeekAt(0)
+	if valueOk || frontOk || backOk || atOk || len(p.PeekValues(1)) != 0 {
+		t.Error("Peeked a value from an empty queue")
+	}
+}
+
+// checkPeekValues checks the peek methods of p, which must hold elemValues in
+// pop order.
+func checkPeekValues(t *testing.T, p queue.Peekable, elemValues []any) {
+	t.Helper()
+
+	if len(elemValues) == 0 {
+		return
+	}
+	if value, ok := p.PeekValue(); !ok || value != elemValues[0] {
+		t.Errorf("The peeked value does not match the result, %v", value)
+		return
+	}
+	if value, ok := p.PeekFront(); !ok || value != elemValues[0] {
+		t.Errorf("The peeked front value does not match the result, %v", value)
+		return
+	}
+	if value, ok := p.PeekBack(); !ok || value != elemValues[len(elemValues)-1] {
+		t.Errorf("The peeked back value does not match the result, %v", value)
+		return
+	}
+	for idx, v := range elemValues {
+		if value, ok := p.PeekAt(idx); !ok || value != v {
+			t.Errorf("The value peeked at %d does not match the result, %v", idx, value)
+			return
+		}
+	}
+	for _, index := range []int{-1, len(elemValues)} {
+		if _, ok := p.PeekAt(index); ok {
+			t.Errorf("Peeked a value out of range at %d", index)
+			return
+		}
+	}
+	checkPoppedValues(t, p.PeekValues(len(elemValues)/2), elemValues[:len(elemValues)/2])
+	checkPoppedValues(t, p.PeekValues(len(elemValues)+1), elemValues)
+}
+
+// checkScanValues checks the scan methods of s, which must hold elemValues in
+// pop order.
+func checkScanValues(t *testing.T, s queue.Scannable, elemValues []any) {
+	t.Helper()
+
+	var scannedValues []any
+	if err := s.ScanElements(func(value any) bool {
+		scannedValues = append(scannedValues, value)
+		return true
+	}); err != nil {
+		t.Errorf("Failed to scan the values, %v", err)
+		return
+	}
+	checkPoppedValues(t, scannedValues, elemValues)
+
+	scannedValues = scannedValues[:0]
+	if err := s.ScanElementsReverse(func(value any) bool {
+		scannedValues = append(scannedValues, value)
+		return true
+	}); err != nil {
+		t.Errorf("Failed to scan the values in reverse, %v", err)
+		return
+	}
+	checkPoppedValues(t, scannedValues, reverseValues(elemValues))
+
+	scannedValues = scannedValues[:0]
+	if err := s.ScanElementsWithIndex(func(index int, value any) bool {
+		if index != len(scannedValues) {
+			t.Errorf("The scanned index %d does not match the result", index)
+			return false
+		}
+		scannedValues = append(scannedValues, value)
+		return true
+	}); err != nil {
+		t.Errorf("Failed to scan the values with index, %v", err)
+		return
+	}
+	checkPoppedValues(t, scannedValues, elemValues)
+
+	// Returning false stops the scan
+	scannedValues = scannedValues[:0]
+	if err := s.ScanElements(func(value any) bool {
+		scannedValues = append(scannedValues, value)
+		return false
+	}); err != nil {
+		t.Errorf("Failed to scan the values, %v", err)
+		return
+	}
+	if len(elemValues) > 0 && len(scannedValues) != 1 {
+		t.Errorf("The scan did not stop, %d values were visited", len(scannedValues))
+	}
+}
+
+// checkListSpacePop runs the list space pop contract against popFunc, which
+// must pop from a queue holding elemValues in pop order.
+func checkListSpacePop(t *testing.T, elemValues []any, popFunc func(ptrListSpace *[]any) (int, error)) {
+	t.Helper()
+
+	if _, err := popFunc(nil); !errors.Is(err, queue.ErrNilListSpace) {
+		t.Errorf("Failed to reject a nil list space, %v", err)
+		return
+	}
+	var zeroCapListSpace []any
+	if _, err := popFunc(&zeroCapListSpace); !errors.Is(err, queue.ErrZeroCapListSpace) {
+		t.Errorf("Failed to reject a zero capacity list space, %v", err)
+		return
+	}
+
+	// A list space with a length is filled in place
+	fixedLen := len(elemValues) / 2
+	fixedListSpace := make([]any, fixedLen)
+	count, err := popFunc(&fixedListSpace)
+	if err != nil || count != fixedLen {
+		t.Errorf("Failed to pop values to the list space, %d, %v", count, err)
+		return
+	}
+	checkPoppedValues(t, fixedListSpace, elemValues[:fixedLen])
+
+	// A list space without a length is appended up to its capacity, and only
+	// receives the remaining values when the queue runs out
+	remainingLen := len(elemValues) - fixedLen
+	appendListSpace := make([]any, 0, remainingLen+1)
+	count, err = popFunc(&appendListSpace)
+	if err != nil || count != remainingLen {
+		t.Errorf("Failed to pop values to the list space, %d, %v", count, err)
+		return
+	}
+	checkPoppedValues(t, appendListSpace, elemValues[fixedLen:])
+}
+
+// checkFilterPop runs the filter pop contract against popFunc, which must pop
+// from a queue holding elemValues in pop order. The value f returns false for
+// is popped as well.
+func checkFilterPop(t *testing.T, elemValues []any, popFunc func(f func(value any) bool) error,
+	popValueFunc func() (any, bool)) {
+	t.Helper()
+
+	if err := popFunc(nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("Failed to reject a nil filter function, %v", err)
+		return
+	}
+
+	stopIdx := len(elemValues) / 2
+	var filteredValues []any
+	if err := popFunc(func(value any) bool {
+		filteredValues = append(filteredValues, value)
+		return len(filteredValues) <= stopIdx
+	}); err != nil {
+		t.Errorf("Failed to pop values with the filter function, %v", err)
+		return
+	}
+	checkPoppedValues(t, filteredValues, elemValues[:stopIdx+1])
+
+	if stopIdx+1 < len(elemValues) {
+		if value, ok := popValueFunc(); !ok || value != elemValues[stopIdx+1] {
+			t.Errorf("The filter function popped beyond the value it rejected, %v", value)
+			return
+		}
+	}
+
+	filteredValues = filteredValues[:0]
+	if err := popFunc(func(value any) bool {
+		filteredValues = append(filteredValues, value)
+		return true
+	}); err != nil {
+		t.Errorf("Failed to pop values with the filter function, %v", err)
+		return
+	}
+	if _, ok := popValueFunc(); ok {
+		t.Error("The filter function did not pop all values")
+		return
+	}
+}
+
+// checkConcurrency pushes values from the producers and pops them from the
+// consumers, every value must be popped once and each consumer must see the
+// values of a producer in the order they were pushed.
+func checkConcurrency(t *testing.T, c *config, pushFunc func(value any) error, popFunc func() (any, bool)) {
+	t.Helper()
+
+	totalLen := c.producers * concurrentValuesLenPerProducer
+	var wg sync.WaitGroup
+	errCh := make(chan error, c.producers+c.consumers)
+	var failed atomic.Bool
+	fail := func(err error) {
+		failed.Store(true)
+		errCh <- err
+	}
+
+	for p := 0; p < c.producers; p++ {
+		wg.Add(1)
+		go func(producer int) {
+			defer wg.Done()
+			for i := 0; i < concurrentValuesLenPerProducer; i++ {
+				for {
+					err := pushFunc(producer*concurrentValuesLenPerProducer + i)
+					if err == nil {
+						break
+					}
+					if !errors.Is(err, queue.ErrFull) {
+						fail(err)
+						return
+					}
+					if failed.Load() {
+						return
+					}
+					runtime.Gosched()
+				}
+			}
+		}(p)
+	}
+
+	var poppedMutex sync.Mutex
+	poppedCounts := make([]int, totalLen)
+	poppedLen := 0
+	for n := 0; n < c.consumers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lastValues := make([]int, c.producers)
+			for i := range lastValues {
+				lastValues[i] = -1
+			}
+
+			for {
+				poppedMutex.Lock()
+				done := poppedLen >= totalLen
+				poppedMutex.Unlock()
+				if done || failed.Load() {
+					return
+				}
+
+				value, ok := popFunc()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+
+				v, isInt := value.(int)
+				if !isInt || v < 0 || v >= totalLen {
+					fail(errors.New("popped an unknown value"))
+					return
+				}
+				producer := v / concurrentValuesLenPerProducer
+				if v <= lastValues[producer] {
+					fail(errors.New("popped the values of a producer out of order"))
+					return
+				}
+				lastValues[producer] = v
+
+				poppedMutex.Lock()
+				poppedCounts[v] += 1
+				poppedLen += 1
+				poppedMutex.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		t.Errorf("Failed to push and pop concurrently, %v", err)
+		return
+	}
+	for v, count := range poppedCounts {
+		if count != 1 {
+			t.Errorf("The value %d was popped %d times", v, count)
+			return
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package queuetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/akley-MK4/go-data-structure/queue"
+)
+
+// RunRingQueueConformance checks that the queues created by newQueueFunc behave
+// like queue.RingQueue. Every case creates a new queue, which must be empty,
+// with the t of the case, so newQueueFunc can fail it with t.Fatal, and
+// values are popped in the order they were pushed, so a priority queue has to
+// order ints ascending to pass. The Peek, Get, Indexable and Scan cases are
+// skipped unless the queue implements the matching optional interface.
+func RunRingQueueConformance(t *testing.T, newQueueFunc func(t *testing.T) queue.IRingQueue, opts ...Option) {
+	c := newConfig(opts)
+
+	newQueue := func(t *testing.T) queue.IRingQueue {
+		t.Helper()
+		q := newQueueFunc(t)
+		if q == nil {
+			t.Fatal("Failed to create a ring queue, the instance is nil")
+		}
+		if !q.IsEmpty() || q.GetLength() != 0 {
+			t.Fatal("Failed to create a ring queue, the instance is not empty")
+		}
+		return q
+	}
+
+	t.Run("Capacity", func(t *testing.T) {
+		q := newQueue(t)
+		availableCapSize := q.GetAvailableCapacitySize()
+		if availableCapSize < 0 {
+			if q.IsFull() {
+				t.Error("An unbounded ring queue is reported full")
+			}
+			return
+		}
+		if availableCapSize == 0 {
+			t.Skip("The ring queue has no capacity to test")
+		}
+
+		for _, value := range newElemValues(availableCapSize) {
+			if err := q.PushValue(value); err != nil {
+				t.Errorf("Failed to push the value to the ring queue, %v", err)
+				return
+			}
+		}
+		if !q.IsFull() || q.GetAvailableCapacitySize() != 0 || q.GetLength() != availableCapSize {
+			t.Error("The ring queue is expected to be full")
+			return
+		}
+		if err := q.PushValue(-1); !errors.Is(err, queue.ErrFull) {
+			t.Errorf("Failed to reject a push on a full ring queue, %v", err)
+			return
+		}
+		checkCapacityError(t, q.PushValues(-1), 1, 0)
+
+		if _, ok := q.PopValue(); !ok {
+			t.Error("Failed to pop a value from a full ring queue")
+			return
+		}
+		if q.IsFull() || q.GetAvailableCapacitySize() != 1 {
+			t.Error("The ring queue is expected to have room for one value")
+			return
+		}
+		checkCapacityError(t, q.PushValues(-1, -1), 2, 1)
+		if q.GetLength() != availableCapSize-1 {
+			t.Error("A rejected batch push changed the length of the ring queue")
+			return
+		}
+	})
+
+	t.Run("Ordering", func(t *testing.T) {
+		q := newQueue(t)
+		elemValues := newElemValues(elemValuesLen(q.GetAvailableCapacitySize()))
+		if err := q.PushValues(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the ring queue, %v", err)
+			return
+		}
+		if q.GetLength() != len(elemValues) {
+			t.Error("Wrong number of elements")
+			return
+		}
+
+		var poppedValues []any
+		for range elemValues {
+			value, ok := q.PopValue()
+			if !ok {
+				t.Error("Failed to pop a value from the ring queue")
+				return
+			}
+			poppedValues = append(poppedValues, value)
+		}
+		checkPoppedValues(t, poppedValues, elemValues)
+		if _, ok := q.PopValue(); ok || !q.IsEmpty() {
+			t.Error("The ring queue is expected to be empty")
+			return
+		}
+
+		// Push and pop across the end of the buffer
+		for i := 0; i < len(elemValues)*3; i++ {
+			if err := q.PushValue(i); err != nil {
+				t.Errorf("Failed to push the value to the ring queue, %v", err)
+				return
+			}
+			if value, ok := q.PopValue(); !ok || value != i {
+				t.Error("The pop-up value does not match the result")
+				return
+			}
+		}
+	})
+
+	t.Run("BatchPop", func(t *testing.T) {
+		q := newQueue(t)
+		if values := q.PopValues(1); len(values) != 0 {
+			t.Error("Popped values from an empty ring queue")
+			return
+		}
+
+		elemValues := newElemValues(elemValuesLen(q.GetAvailableCapacitySize()))
+		if err := q.PushValues(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the ring queue, %v", err)
+			return
+		}
+		onceLen := len(elemValues) / 2
+		checkPoppedValues(t, q.PopValues(onceLen), elemValues[:onceLen])
+		checkPoppedValues(t, q.PopValues(len(elemValues)), elemValues[onceLen:])
+		if !q.IsEmpty() {
+			t.Error("The ring queue is expected to be empty")
+			return
+		}
+	})
+
+	t.Run("ListSpacePop", func(t *testing.T) {
+		q := newQueue(t)
+		elemValues := newElemValues(elemValuesLen(q.GetAvailableCapacitySize()))
+		if err := q.PushValues(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the ring queue, %v", err)
+			return
+		}
+		checkListSpacePop(t, elemValues, q.PopValuesToListSpace)
+	})
+
+	t.Run("FilterPop", func(t *testing.T) {
+		q := newQueue(t)
+		elemValues := newElemValues(elemValuesLen(q.GetAvailableCapacitySize()))
+		if err := q.PushValues(elemValues...); err != nil {
+			t.Errorf("Failed to push values to the ring queue, %v", err)
+			return
+		}
+		checkFilterPop(t, elemValues, q.PopValuesWithFilterFunction, q.PopValue)
+	})
+
+	t.Run("Peek", func(t *testing.T) {
+		q := newQueue(t)
+		p, ok := q.(queue.Peekable)
+		if !ok {
+			t.Skip("The ring queue does not implement queue.Peekable")
+		}
+		checkPeekEmpty(t, p)
+
+		elemValues := pushWrappedValues(t, q)
+		checkPeekValues(t, p, elemValues)
+		if q.GetLength() != len(elemValues) {
+			t.Error("Peeking removed values from the ring queue")
+			return
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		q := newQueue(t)
+		g, ok := q.(interface{ Get(index int) (any, error) })
+		if !ok {
+			t.Skip("The ring queue does not implement Get")
+		}
+		if _, err := g.Get(0); errors.Is(err, queue.ErrUnsupportedOperation) {
+			t.Skip("The ring queue does not support Get")
+		} else if !errors.Is(err, queue.ErrIndexOutOfRange) {
+			t.Errorf("Failed to reject getting from an empty ring queue, %v", err)
+			return
+		}
+
+		elemValues := pushWrappedValues(t, q)
+		for idx, v := range elemValues {
+			if value, err := g.Get(idx); err != nil || value != v {
+				t.Errorf("The value at %d does not match the result, %v, %v", idx, value, err)
+				return
+			}
+		}
+		for _, index := range []int{-1, len(elemValues)} {
+			if _, err := g.Get(index); !errors.Is(err, queue.ErrIndexOutOfRange) {
+				t.Errorf("Failed to reject getting at %d, %v", index, err)
+				return
+			}
+		}
+	})
+
+	t.Run("Indexable", func(t *testing.T) {
+		q := newQueue(t)
+		x, ok := q.(queue.Indexable)
+		if !ok {
+			t.Skip("The ring queue does not implement queue.Indexable")
+		}
+		elemValues := pushWrappedValues(t, q)
+		if len(elemValues) < 2 {
+			t.Skip("The ring queue has no capacity to test")
+		}
+		if err := x.Set(0, -1); errors.Is(err, queue.ErrUnsupportedOperation) {
+			t.Skip("The ring queue does not support index based access")
+		} else if err != nil {
+			t.Errorf("Failed to set the value, %v", err)
+			return
+		}
+		if value, err := x.Get(0); err != nil || value != -1 {
+			t.Errorf("The value set at 0 does not match the result, %v, %v", value, err)
+			return
+		}
+
+		midIdx := len(elemValues) / 2
+		if value, err := x.RemoveAt(midIdx); err != nil || value != elemValues[midIdx] {
+			t.Errorf("The removed value does not match the result, %v, %v", value, err)
+			return
+		}
+		if err := x.InsertAt(midIdx, -2); err != nil {
+			t.Errorf("Failed to insert the value, %v", err)
+			return
+		}
+		if value, err := x.RemoveAt(midIdx); err != nil || value != -2 {
+			t.Errorf("The removed value does not match the result, %v, %v", value, err)
+			return
+		}
+		if value, err := x.RemoveAt(0); err != nil || value != -1 {
+			t.Errorf("The removed value does not match the result, %v, %v", value, err)
+			return
+		}
+		if err := x.InsertAt(0, elemValues[0]); err != nil {
+			t.Errorf("Failed to insert the value, %v", err)
+			return
+		}
+		if err := x.InsertAt(midIdx, elemValues[midIdx]); err != nil {
+			t.Errorf("Failed to insert the value, %v", err)
+			return
+		}
+
+		for _, index := range []int{-1, len(elemValues)} {
+			if err := x.Set(index, 0); !errors.Is(err, queue.ErrIndexOutOfRange) {
+				t.Errorf("Failed to reject setting at %d, %v", index, err)
+				return
+			}
+			if _, err := x.RemoveAt(index); !errors.Is(err, queue.ErrIndexOutOfRange) {
+				t.Errorf("Failed to reject removing at %d, %v", index, err)
+				return
+			}
+		}
+		if err := x.InsertAt(len(elemValues)+1, 0); !errors.Is(err, queue.ErrIndexOutOfRange) {
+			t.Errorf("Failed to reject inserting beyond the length, %v", err)
+			return
+		}
+		checkPoppedValues(t, q.PopValues(len(elemValues)), elemValues)
+	})
+
+	t.Run("Scan", func(t *testing.T) {
+		q := newQueue(t)
+		s, ok := q.(queue.Scannable)
+		if !ok {
+			t.Skip("The ring queue does not implement queue.Scannable")
+		}
+		if err := s.ScanElements(nil); errors.Is(err, queue.ErrUnsupportedOperation) {
+			t.Skip("The ring queue does not support scanning")
+		} else if !errors.Is(err, queue.ErrNilFunc) {
+			t.Errorf("Failed to reject a nil scan function, %v", err)
+			return
+		}
+
+		elemValues := pushWrappedValues(t, q)
+		checkScanValues(t, s, elemValues)
+		if q.GetLength() != len(elemValues) {
+			t.Error("Scanning removed values from the ring queue")
+			return
+		}
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		if c.producers <= 0 || c.consumers <= 0 {
+			t.Skip("The concurrency case is not enabled")
+		}
+		q := newQueue(t)
+		checkConcurrency(t, c, q.PushValue, q.PopValue)
+	})
+}
+
+// pushWrappedValues moves the front of q into the buffer before filling it, so
+// the values wrap around the end of a ring buffer, and returns them in pop
+// order.
+func pushWrappedValues(t *testing.T, q queue.IRingQueue) []any {
+	t.Helper()
+
+	elemValues := newElemValues(elemValuesLen(q.GetAvailableCapacitySize()))
+	halfLen := len(elemValues) / 2
+	if err := q.PushValues(elemValues[:halfLen]...); err != nil {
+		t.Fatalf("Failed to push values to the ring queue, %v", err)
+	}
+	q.PopValues(halfLen)
+	if err := q.PushValues(elemValues...); err != nil {
+		t.Fatalf("Failed to push values to the ring queue, %v", err)
+	}
+	return elemValues
+}
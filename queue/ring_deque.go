@@ -0,0 +1,370 @@
+package queue
+
+const (
+	minRingDequeBufferSize = 16
+)
+
+type RingDeque = GenericRingDeque[any]
+
+func NewRingDeque(capacity int) *RingDeque {
+	return NewGenericRingDeque[any](capacity)
+}
+
+func NewShrinkableRingDeque(capacity int) *RingDeque {
+	return NewGenericShrinkableRingDeque[any](capacity)
+}
+
+// GenericRingDeque is a deque backed by a power-of-two sized circular buffer.
+// The buffer doubles when it is full and, if shrinking is enabled, halves once
+// the length drops to a quarter of it. A negative capacity means unbounded.
+type GenericRingDeque[T any] struct {
+	capacity     int
+	enableShrink bool
+	values       []T
+	head         int
+	length       int
+}
+
+func NewGenericRingDeque[T any](capacity int) *GenericRingDeque[T] {
+	return &GenericRingDeque[T]{
+		capacity: capacity,
+		values:   make([]T, initialRingDequeBufferSize(capacity)),
+	}
+}
+
+func NewGenericShrinkableRingDeque[T any](capacity int) *GenericRingDeque[T] {
+	t := NewGenericRingDeque[T](capacity)
+	t.enableShrink = true
+	return t
+}
+
+func initialRingDequeBufferSize(capacity int) int {
+	if capacity >= 0 && capacity < minRingDequeBufferSize {
+		return roundUpPowerOfTwo(capacity)
+	}
+	return minRingDequeBufferSize
+}
+
+func roundUpPowerOfTwo(n int) int {
+	size := 1
+	for size < n {
+		size <<= 1
+	}
+	return size
+}
+
+func (t *GenericRingDeque[T]) index(offset int) int {
+	return (t.head + offset) & (len(t.values) - 1)
+}
+
+func (t *GenericRingDeque[T]) resize(size int) {
+	values := make([]T, size)
+	if t.head+t.length <= len(t.values) {
+		copy(values, t.values[t.head:t.head+t.length])
+	} else {
+		n := copy(values, t.values[t.head:])
+		copy(values[n:], t.values[:t.length-n])
+	}
+	t.values = values
+	t.head = 0
+}
+
+func (t *GenericRingDeque[T]) growIfNeeded() {
+	if t.length < len(t.values) {
+		return
+	}
+	t.resize(len(t.values) << 1)
+}
+
+func (t *GenericRingDeque[T]) shrinkIfNeeded() {
+	if !t.enableShrink {
+		return
+	}
+
+	size := len(t.values)
+	if size <= minRingDequeBufferSize || t.length > size>>2 {
+		return
+	}
+	t.resize(size >> 1)
+}
+
+func (t *GenericRingDeque[T]) GetLength() int {
+	return t.length
+}
+
+func (t *GenericRingDeque[T]) IsEmpty() bool {
+	return t.length == 0
+}
+
+func (t *GenericRingDeque[T]) IsFull() bool {
+	if t.capacity < 0 {
+		return false
+	}
+
+	return t.length >= t.capacity
+}
+
+func (t *GenericRingDeque[T]) GetAvailableCapacitySize() int {
+	if t.capacity < 0 {
+		return -1
+	}
+
+	return t.capacity - t.length
+}
+
+func (t *GenericRingDeque[T]) CheckAvailableCapacity(pushValueLen int) bool {
+	availableCapSize := t.GetAvailableCapacitySize()
+	if availableCapSize < 0 {
+		return true
+	}
+
+	return availableCapSize >= pushValueLen
+}
+
+// PushValue and PopValue make the queue a FIFO, they are the same as
+// PushValueToBack and PopValueFromFront.
+func (t *GenericRingDeque[T]) PushValue(value T) error {
+	return t.PushValueToBack(value)
+}
+
+func (t *GenericRingDeque[T]) PopValue() (T, bool) {
+	return t.PopValueFromFront()
+}
+
+func (t *GenericRingDeque[T]) PushValueToBack(value T) error {
+	if t.IsFull() {
+		return ErrFull
+	}
+
+	t.growIfNeeded()
+	t.values[t.index(t.length)] = value
+	t.length += 1
+	return nil
+}
+
+func (t *GenericRingDeque[T]) PushValuesToBack(values ...T) error {
+	if !t.CheckAvailableCapacity(len(values)) {
+		return newCapacityError(len(values), t.GetAvailableCapacitySize())
+	}
+
+	for _, value := range values {
+		if err := t.PushValueToBack(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericRingDeque[T]) PushValuesToBackWithoutCheck(values ...T) (retPushedCount int) {
+	for _, value := range values {
+		if err := t.PushValueToBack(value); err != nil {
+			return
+		}
+		retPushedCount += 1
+	}
+
+	return
+}
+
+func (t *GenericRingDeque[T]) PushValueToFront(value T) error {
+	if t.IsFull() {
+		return ErrFull
+	}
+
+	t.growIfNeeded()
+	t.head = t.index(len(t.values) - 1)
+	t.values[t.head] = value
+	t.length += 1
+	return nil
+}
+
+func (t *GenericRingDeque[T]) PushValuesToFront(values ...T) error {
+	if !t.CheckAvailableCapacity(len(values)) {
+		return newCapacityError(len(values), t.GetAvailableCapacitySize())
+	}
+
+	for _, value := range values {
+		if err := t.PushValueToFront(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericRingDeque[T]) PushValuesToFrontWithoutCheck(values ...T) (retPushedCount int) {
+	for _, value := range values {
+		if err := t.PushValueToFront(value); err != nil {
+			return
+		}
+		retPushedCount += 1
+	}
+
+	return
+}
+
+func (t *GenericRingDeque[T]) PopValueFromFront() (T, bool) {
+	var zero T
+	if t.length <= 0 {
+		return zero, false
+	}
+
+	retValue := t.values[t.head]
+	t.values[t.head] = zero
+	t.head = t.index(1)
+	t.length -= 1
+	t.shrinkIfNeeded()
+	return retValue, true
+}
+
+func (t *GenericRingDeque[T]) PopValuesFromFront(count int) (retValues []T) {
+	valuesLen := t.length
+	if valuesLen <= 0 {
+		return
+	}
+
+	retValuesCap := count
+	if valuesLen < count {
+		retValuesCap = valuesLen
+	}
+	retValues = make([]T, 0, retValuesCap)
+
+	for i := 0; i < count; i++ {
+		val, valid := t.PopValueFromFront()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, val)
+	}
+
+	return
+}
+
+func (t *GenericRingDeque[T]) PopValuesFromFrontToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValueFromFront)
+}
+
+func (t *GenericRingDeque[T]) PopValuesFromFrontWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValueFromFront()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
+
+func (t *GenericRingDeque[T]) PopValueFromBack() (T, bool) {
+	var zero T
+	if t.length <= 0 {
+		return zero, false
+	}
+
+	idx := t.index(t.length - 1)
+	retValue := t.values[idx]
+	t.values[idx] = zero
+	t.length -= 1
+	t.shrinkIfNeeded()
+	return retValue, true
+}
+
+func (t *GenericRingDeque[T]) PopValuesFromBack(count int) (retValues []T) {
+	valuesLen := t.length
+	if valuesLen <= 0 {
+		return
+	}
+
+	retValuesCap := count
+	if valuesLen < count {
+		retValuesCap = valuesLen
+	}
+	retValues = make([]T, 0, retValuesCap)
+
+	for i := 0; i < count; i++ {
+		val, valid := t.PopValueFromBack()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, val)
+	}
+
+	return
+}
+
+func (t *GenericRingDeque[T]) PopValuesFromBackToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValueFromBack)
+}
+
+func (t *GenericRingDeque[T]) PopValuesFromBackWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValueFromBack()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
+
+func (t *GenericRingDeque[T]) PeekValue() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericRingDeque[T]) PeekValues(count int) (retValues []T) {
+	valuesLen := t.length
+	if count > valuesLen {
+		count = valuesLen
+	}
+
+	for i := 0; i < count; i++ {
+		value, _ := t.PeekAt(i)
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericRingDeque[T]) PeekFront() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericRingDeque[T]) PeekBack() (T, bool) {
+	return t.PeekAt(t.length - 1)
+}
+
+func (t *GenericRingDeque[T]) PeekAt(index int) (T, bool) {
+	if index < 0 || index >= t.length {
+		var zero T
+		return zero, false
+	}
+
+	return t.values[t.index(index)], true
+}
+
+func (t *GenericRingDeque[T]) ScanElementsFromFront(f func(value T) bool) {
+	for i := 0; i < t.length; i++ {
+		if !f(t.values[t.index(i)]) {
+			return
+		}
+	}
+}
+
+func (t *GenericRingDeque[T]) ScanElementsFromBack(f func(value T) bool) {
+	for i := t.length - 1; i >= 0; i-- {
+		if !f(t.values[t.index(i)]) {
+			return
+		}
+	}
+}
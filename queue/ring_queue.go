@@ -1,47 +1,196 @@
 package queue
 
-import (
-	"errors"
-	"sync"
+const (
+	minAutoResizingCapacity = 2
 )
 
-type RingQueue struct {
-	lock     sync.Mutex
-	capacity int
-	values   []interface{}
-	front    int
-	back     int
+type IGenericRingQueue[T any] interface {
+	GetLength() int
+	IsEmpty() bool
+	IsFull() bool
+	GetAvailableCapacitySize() int
+	PushValue(value T) error
+	PushValues(values ...T) error
+	PopValue() (T, bool)
+	PopValues(count int) (retValues []T)
+	PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error)
+	PopValuesWithFilterFunction(f func(value T) bool) (retErr error)
 }
 
+type RingQueue = GenericRingQueue[any]
+
 func NewRingQueue(capacity int) *RingQueue {
-	return &RingQueue{
+	return NewGenericRingQueue[any](capacity)
+}
+
+func NewOverwritingRingQueue(capacity int, evictFunc func(value any)) *RingQueue {
+	return NewGenericOverwritingRingQueue[any](capacity, evictFunc)
+}
+
+func NewAutoResizingRingQueue(capacity, maxCapacity int) *RingQueue {
+	return NewGenericAutoResizingRingQueue[any](capacity, maxCapacity)
+}
+
+type GenericRingQueue[T any] struct {
+	capacity  int
+	values    []T
+	front     int
+	back      int
+	overwrite bool
+	evictFunc func(value T)
+	// deferEvict collects evicted values in evictedValues instead of calling
+	// evictFunc, a safety wrapper calls it once its lock is released
+	deferEvict    bool
+	evictedValues []T
+
+	autoResize  bool
+	minCapacity int
+	maxCapacity int
+}
+
+func NewGenericRingQueue[T any](capacity int) *GenericRingQueue[T] {
+	return &GenericRingQueue[T]{
 		capacity: capacity,
-		values:   make([]interface{}, capacity),
+		values:   make([]T, capacity),
 		front:    0,
 		back:     0,
 	}
 }
 
-func (t *RingQueue) IsEmpty() bool {
+// NewGenericOverwritingRingQueue creates a ring queue that keeps the most recent
+// values, a push on a full queue evicts the front value and passes it to
+// evictFunc, which may be nil. The queue calls evictFunc inside PushValue, once
+// wrapped by NewSafetyRingDeque the calls are made after the wrapper releases
+// its lock, so evictFunc may use the wrapper but the values may already be
+// reordered with respect to later pushes from other goroutines.
+func NewGenericOverwritingRingQueue[T any](capacity int, evictFunc func(value T)) *GenericRingQueue[T] {
+	t := NewGenericRingQueue[T](capacity)
+	t.overwrite = true
+	t.evictFunc = evictFunc
+	return t
+}
+
+// NewGenericAutoResizingRingQueue creates a ring queue that doubles its capacity
+// when a push finds it full, up to maxCapacity (negative means unbounded), and
+// halves it once the length drops to a quarter, never below the initial capacity.
+// The initial capacity is raised to minAutoResizingCapacity so the queue can
+// hold a value and grow, and a non-negative maxCapacity to the initial capacity.
+func NewGenericAutoResizingRingQueue[T any](capacity, maxCapacity int) *GenericRingQueue[T] {
+	if capacity < minAutoResizingCapacity {
+		capacity = minAutoResizingCapacity
+	}
+	if maxCapacity >= 0 && maxCapacity < capacity {
+		maxCapacity = capacity
+	}
+
+	t := NewGenericRingQueue[T](capacity)
+	t.autoResize = true
+	t.minCapacity = capacity
+	t.maxCapacity = maxCapacity
+	return t
+}
+
+func (t *GenericRingQueue[T]) IsOverwriting() bool {
+	return t.overwrite
+}
+
+func (t *GenericRingQueue[T]) IsAutoResizing() bool {
+	return t.autoResize
+}
+
+func (t *GenericRingQueue[T]) GetCapacity() int {
+	return t.capacity
+}
+
+func (t *GenericRingQueue[T]) IsEmpty() bool {
 	return t.front == t.back
 }
 
-func (t *RingQueue) IsFull() bool {
+func (t *GenericRingQueue[T]) IsFull() bool {
+	return t.isBufferFull() && !t.canGrow()
+}
+
+func (t *GenericRingQueue[T]) isBufferFull() bool {
 	return t.front == ((t.back + 1) % t.capacity)
-	//return t.front == (t.back % t.capacity)
 }
 
-func (t *RingQueue) GetLength() int {
+func (t *GenericRingQueue[T]) canGrow() bool {
+	return t.autoResize && (t.maxCapacity < 0 || t.capacity < t.maxCapacity)
+}
+
+func (t *GenericRingQueue[T]) GetLength() int {
 	return (t.back - t.front + t.capacity) % t.capacity
 }
 
-func (t *RingQueue) GetAvailableCapacitySize() int {
-	return t.capacity - t.GetLength()
+func (t *GenericRingQueue[T]) GetAvailableCapacitySize() int {
+	capacity := t.capacity
+	if t.autoResize {
+		if t.maxCapacity < 0 {
+			return -1
+		}
+		if t.maxCapacity > capacity {
+			capacity = t.maxCapacity
+		}
+	}
+
+	// One slot is always kept empty to tell a full queue from an empty one, so
+	// counting it would let PushValues pass its check and then fail halfway
+	return capacity - 1 - t.GetLength()
+}
+
+// Resize changes the capacity to newCapacity while keeping the values in
+// order, it fails if newCapacity cannot hold the current values. Resizing an
+// auto-resizing queue beyond its maxCapacity raises maxCapacity to newCapacity.
+func (t *GenericRingQueue[T]) Resize(newCapacity int) error {
+	valuesLen := t.GetLength()
+	if newCapacity < 1 || newCapacity-1 < valuesLen {
+		return newCapacityError(valuesLen, newCapacity-1)
+	}
+	if t.autoResize && t.maxCapacity >= 0 && newCapacity > t.maxCapacity {
+		t.maxCapacity = newCapacity
+	}
+
+	values := make([]T, newCapacity)
+	for i := 0; i < valuesLen; i++ {
+		values[i] = t.values[t.position(i)]
+	}
+	t.values = values
+	t.capacity = newCapacity
+	t.front = 0
+	t.back = valuesLen
+	return nil
+}
+
+func (t *GenericRingQueue[T]) grow() {
+	newCapacity := t.capacity << 1
+	if t.maxCapacity >= 0 && newCapacity > t.maxCapacity {
+		newCapacity = t.maxCapacity
+	}
+	_ = t.Resize(newCapacity)
 }
 
-func (t *RingQueue) PushValue(value interface{}) error {
-	if t.IsFull() {
-		return errors.New("the queue capacity is already full")
+func (t *GenericRingQueue[T]) shrinkIfNeeded() {
+	if !t.autoResize || t.capacity <= t.minCapacity || t.GetLength() > t.capacity>>2 {
+		return
+	}
+
+	newCapacity := t.capacity >> 1
+	if newCapacity < t.minCapacity {
+		newCapacity = t.minCapacity
+	}
+	_ = t.Resize(newCapacity)
+}
+
+func (t *GenericRingQueue[T]) PushValue(value T) error {
+	if t.isBufferFull() {
+		switch {
+		case t.canGrow():
+			t.grow()
+		case !t.overwrite || t.IsEmpty():
+			return ErrFull
+		default:
+			t.evictFront()
+		}
 	}
 
 	t.values[t.back] = value
@@ -49,14 +198,15 @@ func (t *RingQueue) PushValue(value interface{}) error {
 	return nil
 }
 
-func (t *RingQueue) PushValues(values ...interface{}) error {
+func (t *GenericRingQueue[T]) PushValues(values ...T) error {
 	valuesLen := len(values)
 	if valuesLen <= 0 {
 		return nil
 	}
 
-	if valuesLen > t.GetAvailableCapacitySize() {
-		return errors.New("the capacity size of the queue is insufficient")
+	availableCapSize := t.GetAvailableCapacitySize()
+	if availableCapSize >= 0 && valuesLen > availableCapSize && !t.overwrite {
+		return newCapacityError(valuesLen, availableCapSize)
 	}
 
 	for _, value := range values {
@@ -68,7 +218,7 @@ func (t *RingQueue) PushValues(values ...interface{}) error {
 	return nil
 }
 
-func (t *RingQueue) PushValuesWithoutCheck(values ...interface{}) (retPushedCount int) {
+func (t *GenericRingQueue[T]) PushValuesWithoutCheck(values ...T) (retPushedCount int) {
 	for _, value := range values {
 		if err := t.PushValue(value); err != nil {
 			return
@@ -79,72 +229,70 @@ func (t *RingQueue) PushValuesWithoutCheck(values ...interface{}) (retPushedCoun
 	return
 }
 
-func (t *RingQueue) PopValue() (interface{}, bool) {
+func (t *GenericRingQueue[T]) PopValue() (T, bool) {
+	var zero T
 	if t.IsEmpty() {
-		return nil, false
+		return zero, false
 	}
 
 	retValue := t.values[t.front]
-	t.values[t.front] = nil
+	t.values[t.front] = zero
 	t.front = (t.front + 1) % t.capacity
+	t.shrinkIfNeeded()
 	return retValue, true
 }
 
-func (t *RingQueue) PopValues(count int) (retValues []interface{}) {
-	for i := 0; i < count; i++ {
-		value, valid := t.PopValue()
-		if !valid {
-			return
-		}
-		retValues = append(retValues, value)
+func (t *GenericRingQueue[T]) evictFront() {
+	evictedValue, ok := t.PopValue()
+	if !ok || t.evictFunc == nil {
+		return
 	}
-
-	return
-}
-
-func (t *RingQueue) PopValuesToListSpace(ptrListSpace *[]any) (retCount int, retErr error) {
-	if ptrListSpace == nil {
-		retErr = errors.New("the parameter listSpace is a nil value")
+	if t.deferEvict {
+		t.evictedValues = append(t.evictedValues, evictedValue)
 		return
 	}
+	t.evictFunc(evictedValue)
+}
 
-	listSpace := *ptrListSpace
-	listSpaceLen := len(listSpace)
-	if listSpaceLen > 0 {
-		for i := 0; i < listSpaceLen; i++ {
-			val, valid := t.PopValue()
-			if !valid {
-				return
-			}
+func (t *GenericRingQueue[T]) deferEvictFunc() {
+	t.deferEvict = true
+}
 
-			listSpace[i] = val
-			retCount += 1
-		}
-		return
+// takeEvictCallback returns a function passing the values evicted since the
+// last call to evictFunc, or nil if there are none.
+func (t *GenericRingQueue[T]) takeEvictCallback() func() {
+	if len(t.evictedValues) == 0 {
+		return nil
 	}
 
-	listSpaceCap := cap(listSpace)
-	if listSpaceCap <= 0 {
-		retErr = errors.New("the capacity of the parameter listSpace is 0")
-		return
+	evictedValues, evictFunc := t.evictedValues, t.evictFunc
+	t.evictedValues = nil
+	return func() {
+		for _, value := range evictedValues {
+			evictFunc(value)
+		}
 	}
+}
 
-	for i := 0; i < listSpaceCap; i++ {
-		val, valid := t.PopValue()
+func (t *GenericRingQueue[T]) PopValues(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValue()
 		if !valid {
 			return
 		}
-
-		*ptrListSpace = append(*ptrListSpace, val)
-		retCount += 1
+		retValues = append(retValues, value)
 	}
 
 	return
 }
 
-func (t *RingQueue) PopValuesWithFilterFunction(f func(value interface{}) bool) (retErr error) {
+func (t *GenericRingQueue[T]) PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValue)
+}
+
+func (t *GenericRingQueue[T]) PopValuesWithFilterFunction(f func(value T) bool) (retErr error) {
 	if f == nil {
-		return errors.New("the parameter f is a nil value")
+		return ErrNilFunc
 	}
 
 	for {
@@ -156,17 +304,159 @@ func (t *RingQueue) PopValuesWithFilterFunction(f func(value interface{}) bool)
 			return
 		}
 	}
+}
+
+func (t *GenericRingQueue[T]) PeekValue() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericRingQueue[T]) PeekValues(count int) (retValues []T) {
+	valuesLen := t.GetLength()
+	if count > valuesLen {
+		count = valuesLen
+	}
+
+	for i := 0; i < count; i++ {
+		value, _ := t.PeekAt(i)
+		retValues = append(retValues, value)
+	}
 
 	return
 }
 
-func (t *RingQueue) ScanElements(f func(value interface{}) bool) error {
+func (t *GenericRingQueue[T]) PeekFront() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericRingQueue[T]) PeekBack() (T, bool) {
+	return t.PeekAt(t.GetLength() - 1)
+}
+
+func (t *GenericRingQueue[T]) PeekAt(index int) (T, bool) {
+	if index < 0 || index >= t.GetLength() {
+		var zero T
+		return zero, false
+	}
+
+	return t.values[t.position(index)], true
+}
+
+func (t *GenericRingQueue[T]) position(index int) int {
+	return (t.front + index) % t.capacity
+}
+
+func (t *GenericRingQueue[T]) Get(index int) (T, error) {
+	valuesLen := t.GetLength()
+	if index < 0 || index >= valuesLen {
+		var zero T
+		return zero, newIndexError(index, valuesLen)
+	}
+
+	return t.values[t.position(index)], nil
+}
+
+func (t *GenericRingQueue[T]) Set(index int, value T) error {
+	valuesLen := t.GetLength()
+	if index < 0 || index >= valuesLen {
+		return newIndexError(index, valuesLen)
+	}
+
+	t.values[t.position(index)] = value
+	return nil
+}
+
+// RemoveAt and InsertAt shift the values on the shorter side of the index, so
+// they are O(1) at either end and O(n) in the middle.
+func (t *GenericRingQueue[T]) RemoveAt(index int) (T, error) {
+	var zero T
+	valuesLen := t.GetLength()
+	if index < 0 || index >= valuesLen {
+		return zero, newIndexError(index, valuesLen)
+	}
+
+	retValue := t.values[t.position(index)]
+	if index < valuesLen-1-index {
+		for i := index; i > 0; i-- {
+			t.values[t.position(i)] = t.values[t.position(i-1)]
+		}
+		t.values[t.front] = zero
+		t.front = (t.front + 1) % t.capacity
+	} else {
+		for i := index; i < valuesLen-1; i++ {
+			t.values[t.position(i)] = t.values[t.position(i+1)]
+		}
+		t.back = (t.back - 1 + t.capacity) % t.capacity
+		t.values[t.back] = zero
+	}
+	t.shrinkIfNeeded()
+	return retValue, nil
+}
+
+// InsertAt inserts the value before the value at the index, an index equal to
+// the length appends it. A full queue rejects it even in overwriting mode.
+func (t *GenericRingQueue[T]) InsertAt(index int, value T) error {
+	valuesLen := t.GetLength()
+	if index < 0 || index > valuesLen {
+		return newIndexError(index, valuesLen)
+	}
+	if t.isBufferFull() {
+		if !t.canGrow() {
+			return ErrFull
+		}
+		t.grow()
+	}
+
+	if index < valuesLen-index {
+		t.front = (t.front - 1 + t.capacity) % t.capacity
+		for i := 0; i < index; i++ {
+			t.values[t.position(i)] = t.values[t.position(i+1)]
+		}
+	} else {
+		for i := valuesLen; i > index; i-- {
+			t.values[t.position(i)] = t.values[t.position(i-1)]
+		}
+		t.back = (t.back + 1) % t.capacity
+	}
+	t.values[t.position(index)] = value
+	return nil
+}
+
+// ScanElements visits the values from the front to the back until f returns
+// false.
+func (t *GenericRingQueue[T]) ScanElements(f func(value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	return t.ScanElementsWithIndex(func(index int, value T) bool {
+		return f(value)
+	})
+}
+
+func (t *GenericRingQueue[T]) ScanElementsReverse(f func(value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for i := t.GetLength() - 1; i >= 0; i-- {
+		if !f(t.values[t.position(i)]) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericRingQueue[T]) ScanElementsWithIndex(f func(index int, value T) bool) error {
 	if f == nil {
-		return errors.New("the parameter f is a nil value")
+		return ErrNilFunc
 	}
 
-	for _, v := range t.values {
-		f(v)
+	valuesLen := t.GetLength()
+	for i := 0; i < valuesLen; i++ {
+		if !f(i, t.values[t.position(i)]) {
+			return nil
+		}
 	}
 
 	return nil
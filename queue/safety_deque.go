@@ -1,161 +1,143 @@
 package queue
 
 import (
-	"errors"
-	"sync"
+	"context"
 )
 
-type IDeque interface {
+type IGenericDeque[T any] interface {
 	GetLength() int
 	IsEmpty() bool
 	IsFull() bool
 	GetAvailableCapacitySize() int
 	CheckAvailableCapacity(pushValueLen int) bool
 
-	PushValueToBack(value any) error
-	PushValuesToBack(values ...any) error
-	PushValueToFront(value any) error
-	PushValuesToFront(values ...any) error
+	PushValueToBack(value T) error
+	PushValuesToBack(values ...T) error
+	PushValueToFront(value T) error
+	PushValuesToFront(values ...T) error
 
-	PopValueFromFront() (any, bool)
-	PopValuesFromFront(count int) (retValues []any)
-	PopValuesFromFrontWithFilterFunction(f func(value interface{}) bool) (retErr error)
-	PopValueFromBack() (any, bool)
-	PopValuesFromBack(count int) (retValues []any)
-	PopValuesFromBackWithFilterFunction(f func(value interface{}) bool) (retErr error)
+	PopValueFromFront() (T, bool)
+	PopValuesFromFront(count int) (retValues []T)
+	PopValuesFromFrontToListSpace(ptrListSpace *[]T) (retCount int, retErr error)
+	PopValuesFromFrontWithFilterFunction(f func(value T) bool) (retErr error)
+	PopValueFromBack() (T, bool)
+	PopValuesFromBack(count int) (retValues []T)
+	PopValuesFromBackToListSpace(ptrListSpace *[]T) (retCount int, retErr error)
+	PopValuesFromBackWithFilterFunction(f func(value T) bool) (retErr error)
 }
 
+type IDeque = IGenericDeque[any]
+
 func NewSafetyDeque(newDequeFunc func() IDeque) (*SafetyDeque, error) {
 	inst := newDequeFunc()
 	if inst == nil {
-		return nil, errors.New("the created deque instance is a nil value")
+		return nil, ErrNilInstance
 	}
 
-	return &SafetyDeque{
+	t := &SafetyDeque{
 		inst: inst,
-	}, nil
+	}
+	t.init(NewDequeFIFOAdapter(inst))
+	return t, nil
 }
 
+// SafetyDeque is a SafetyQueue over a deque with the deque specific methods on
+// top, the FIFO methods of the SafetyQueue push to the back and pop from the
+// front.
 type SafetyDeque struct {
-	rwMutex sync.RWMutex
-	inst    IDeque
+	SafetyQueue
+	inst IDeque
 }
 
 func (t *SafetyDeque) GetQueueInstance() IDeque {
 	return t.inst
 }
 
-func (t *SafetyDeque) GetLength() int {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-
-	return t.inst.GetLength()
-}
-
-func (t *SafetyDeque) IsEmpty() bool {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-
-	return t.inst.IsEmpty()
-}
-
-func (t *SafetyDeque) IsFull() bool {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-
-	return t.inst.IsFull()
-}
-
-func (t *SafetyDeque) GetAvailableCapacitySize() int {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-
-	return t.inst.GetAvailableCapacitySize()
-}
-
-func (t *SafetyDeque) CheckAvailableCapacity(pushValueLen int) bool {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-
-	return t.inst.CheckAvailableCapacity(pushValueLen)
+func (t *SafetyDeque) CheckAvailableCapacity(pushValueLen int) (retOk bool) {
+	t.ExecuteReadMethod(func() {
+		retOk = t.inst.CheckAvailableCapacity(pushValueLen)
+	})
+	return
 }
 
 func (t *SafetyDeque) PushValueToBack(value any) error {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
+	return t.PushValue(value)
+}
 
-	return t.inst.PushValueToBack(value)
+func (t *SafetyDeque) PushValueToBackWait(ctx context.Context, value any) error {
+	return t.PushValueWait(ctx, value)
 }
 
 func (t *SafetyDeque) PushValuesToBack(values ...any) error {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-
-	return t.inst.PushValuesToBack(values...)
+	return t.PushValues(values...)
 }
 
 func (t *SafetyDeque) PushValueToFront(value any) error {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
+	return t.executePushMethod(func() error {
+		return t.inst.PushValueToFront(value)
+	})
+}
 
-	return t.inst.PushValueToFront(value)
+func (t *SafetyDeque) PushValueToFrontWait(ctx context.Context, value any) error {
+	return t.pushValueWait(ctx, func() error {
+		return t.inst.PushValueToFront(value)
+	})
 }
 
 func (t *SafetyDeque) PushValuesToFront(values ...any) error {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-
-	return t.inst.PushValuesToFront(values...)
+	return t.executePushMethod(func() error {
+		return t.inst.PushValuesToFront(values...)
+	})
 }
 
 func (t *SafetyDeque) PopValueFromFront() (any, bool) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
+	return t.PopValue()
+}
 
-	return t.inst.PopValueFromFront()
+func (t *SafetyDeque) PopValueFromFrontWait(ctx context.Context) (any, error) {
+	return t.PopValueWait(ctx)
 }
 
 func (t *SafetyDeque) PopValuesFromFront(count int) (retValues []any) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
+	return t.PopValues(count)
+}
 
-	return t.inst.PopValuesFromFront(count)
+func (t *SafetyDeque) PopValuesFromFrontToListSpace(ptrListSpace *[]any) (retCount int, retErr error) {
+	return t.PopValuesToListSpace(ptrListSpace)
 }
 
 func (t *SafetyDeque) PopValuesFromFrontWithFilterFunction(f func(value interface{}) bool) (retErr error) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PopValuesFromFrontWithFilterFunction(f)
+	return t.PopValuesWithFilterFunction(f)
 }
 
-func (t *SafetyDeque) PopValueFromBack() (any, bool) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-
-	return t.inst.PopValueFromBack()
+func (t *SafetyDeque) PopValueFromBack() (retValue any, retOk bool) {
+	t.ExecuteWriteMethod(func() {
+		retValue, retOk = t.inst.PopValueFromBack()
+	})
+	return
 }
 
-func (t *SafetyDeque) PopValuesFromBack(count int) (retValues []any) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-
-	return t.inst.PopValuesFromBack(count)
+func (t *SafetyDeque) PopValueFromBackWait(ctx context.Context) (any, error) {
+	return t.popValueWait(ctx, t.inst.PopValueFromBack)
 }
 
-func (t *SafetyDeque) PopValuesFromBackWithFilterFunction(f func(value interface{}) bool) (retErr error) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.PopValuesFromBackWithFilterFunction(f)
+func (t *SafetyDeque) PopValuesFromBack(count int) (retValues []any) {
+	t.ExecuteWriteMethod(func() {
+		retValues = t.inst.PopValuesFromBack(count)
+	})
+	return
 }
 
-func (t *SafetyDeque) ExecuteWriteMethod(f func()) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	f()
+func (t *SafetyDeque) PopValuesFromBackToListSpace(ptrListSpace *[]any) (retCount int, retErr error) {
+	t.ExecuteWriteMethod(func() {
+		retCount, retErr = t.inst.PopValuesFromBackToListSpace(ptrListSpace)
+	})
+	return
 }
 
-func (t *SafetyDeque) ExecuteReadMethod(f func()) {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-	f()
+func (t *SafetyDeque) PopValuesFromBackWithFilterFunction(f func(value interface{}) bool) (retErr error) {
+	t.ExecuteWriteMethod(func() {
+		retErr = t.inst.PopValuesFromBackWithFilterFunction(f)
+	})
+	return
 }
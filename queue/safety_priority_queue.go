@@ -0,0 +1,31 @@
+package queue
+
+func NewSafetyPriorityQueue(newQueueFunc func() *PriorityQueue) (*SafetyPriorityQueue, error) {
+	inst := newQueueFunc()
+	if inst == nil {
+		return nil, ErrNilInstance
+	}
+
+	safetyRingQueue, err := NewSafetyRingDeque(func() IRingQueue {
+		return inst
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SafetyPriorityQueue{
+		SafetyRingQueue: safetyRingQueue,
+		inst:            inst,
+	}, nil
+}
+
+// SafetyPriorityQueue shares the locking, waiting and closing behaviour of
+// SafetyRingQueue and adds the operations specific to a priority queue.
+type SafetyPriorityQueue struct {
+	*SafetyRingQueue
+	inst *PriorityQueue
+}
+
+func (t *SafetyPriorityQueue) GetPriorityQueueInstance() *PriorityQueue {
+	return t.inst
+}
@@ -0,0 +1,403 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type SafetyQueue = GenericSafetyQueue[any]
+
+func NewSafetyQueue(newQueueFunc func() FIFO) (*SafetyQueue, error) {
+	return NewGenericSafetyQueue[any](newQueueFunc)
+}
+
+func NewGenericSafetyQueue[T any](newQueueFunc func() GenericFIFO[T]) (*GenericSafetyQueue[T], error) {
+	inst := newQueueFunc()
+	if inst == nil {
+		return nil, ErrNilInstance
+	}
+
+	t := &GenericSafetyQueue[T]{}
+	t.init(inst)
+	return t, nil
+}
+
+// GenericSafetyQueue makes any FIFO safe for concurrent use. It owns the lock,
+// the waiters and the close state, SafetyRingQueue and SafetyDeque embed it and
+// only add the methods specific to their instance. The optional methods of the
+// wrapped queue are found by type assertion: a queue that is not Bounded is
+// treated as unbounded, and one that is not Peekable has no values to peek.
+type GenericSafetyQueue[T any] struct {
+	rwMutex          sync.RWMutex
+	inst             GenericFIFO[T]
+	notEmptyNotifier waitNotifier
+	notFullNotifier  waitNotifier
+	closeState       closeState
+	// waiterCount counts the goroutines blocked in a Wait method, it is
+	// raised before the lock is released so a counted waiter cannot miss a
+	// notification
+	waiterCount atomic.Int64
+	evictor     evictDeferrer
+}
+
+// evictDeferrer is implemented by the queues with an eviction callback, the
+// safety queue has them defer the callback so it never runs under rwMutex.
+type evictDeferrer interface {
+	deferEvictFunc()
+	takeEvictCallback() func()
+}
+
+func (t *GenericSafetyQueue[T]) init(inst GenericFIFO[T]) {
+	t.inst = inst
+	t.closeState = newCloseState()
+	if evictor, ok := inst.(evictDeferrer); ok {
+		evictor.deferEvictFunc()
+		t.evictor = evictor
+	}
+}
+
+// unlock releases the write lock and then runs the eviction callbacks
+// collected while it was held.
+func (t *GenericSafetyQueue[T]) unlock() {
+	var evictCallback func()
+	if t.evictor != nil {
+		evictCallback = t.evictor.takeEvictCallback()
+	}
+	t.rwMutex.Unlock()
+	if evictCallback != nil {
+		evictCallback()
+	}
+}
+
+// lock takes the write lock and returns the length of the queue, which
+// notifyWaiters compares with the length once the write is done.
+func (t *GenericSafetyQueue[T]) lock() int {
+	t.rwMutex.Lock()
+	return t.inst.GetLength()
+}
+
+// notifyWaiters wakes the poppers if the queue grew since it held lengthBefore
+// values and the pushers if it shrank, a write that left the length unchanged
+// neither added a value nor freed room for one.
+func (t *GenericSafetyQueue[T]) notifyWaiters(lengthBefore int) {
+	length := t.inst.GetLength()
+	if length > lengthBefore {
+		t.notEmptyNotifier.notify()
+	} else if length < lengthBefore {
+		t.notFullNotifier.notify()
+	}
+	t.closeState.checkDrained(length == 0)
+}
+
+// notifyAllWaiters wakes every waiter, for the changes the length does not
+// show, such as closing the queue or growing its capacity.
+func (t *GenericSafetyQueue[T]) notifyAllWaiters() {
+	t.notEmptyNotifier.notify()
+	t.notFullNotifier.notify()
+	t.closeState.checkDrained(t.inst.IsEmpty())
+}
+
+// executePushMethod runs pushFunc under the write lock unless the queue is
+// closed.
+func (t *GenericSafetyQueue[T]) executePushMethod(pushFunc func() error) error {
+	lengthBefore := t.lock()
+	defer t.unlock()
+	defer t.notifyWaiters(lengthBefore)
+	if t.closeState.closed {
+		return ErrClosed
+	}
+	return pushFunc()
+}
+
+func (t *GenericSafetyQueue[T]) GetQueueInstance() GenericFIFO[T] {
+	return t.inst
+}
+
+func (t *GenericSafetyQueue[T]) Close() error {
+	t.rwMutex.Lock()
+	defer t.unlock()
+	defer t.notifyAllWaiters()
+	return t.closeState.close()
+}
+
+func (t *GenericSafetyQueue[T]) IsClosed() bool {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.closeState.closed
+}
+
+func (t *GenericSafetyQueue[T]) Done() <-chan struct{} {
+	return t.closeState.doneChan()
+}
+
+func (t *GenericSafetyQueue[T]) GetLength() int {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.inst.GetLength()
+}
+
+func (t *GenericSafetyQueue[T]) IsEmpty() bool {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.inst.IsEmpty()
+}
+
+func (t *GenericSafetyQueue[T]) IsFull() bool {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	if inst, ok := t.inst.(Bounded); ok {
+		return inst.IsFull()
+	}
+	return false
+}
+
+func (t *GenericSafetyQueue[T]) GetAvailableCapacitySize() int {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	return t.getAvailableCapacitySize()
+}
+
+func (t *GenericSafetyQueue[T]) getAvailableCapacitySize() int {
+	if inst, ok := t.inst.(Bounded); ok {
+		return inst.GetAvailableCapacitySize()
+	}
+	return -1
+}
+
+func (t *GenericSafetyQueue[T]) PushValue(value T) error {
+	return t.executePushMethod(func() error {
+		return t.inst.PushValue(value)
+	})
+}
+
+func (t *GenericSafetyQueue[T]) PushValueWait(ctx context.Context, value T) error {
+	return t.pushValueWait(ctx, func() error {
+		return t.inst.PushValue(value)
+	})
+}
+
+func (t *GenericSafetyQueue[T]) PushValueWaitTimeout(value T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return t.PushValueWait(ctx, value)
+}
+
+// PushValues pushes all the values or none of them.
+func (t *GenericSafetyQueue[T]) PushValues(values ...T) error {
+	return t.executePushMethod(func() error {
+		return t.pushValues(values)
+	})
+}
+
+func (t *GenericSafetyQueue[T]) pushValues(values []T) error {
+	if inst, ok := t.inst.(interface{ PushValues(values ...T) error }); ok {
+		return inst.PushValues(values...)
+	}
+
+	availableCapSize := t.getAvailableCapacitySize()
+	if availableCapSize >= 0 && len(values) > availableCapSize {
+		return newCapacityError(len(values), availableCapSize)
+	}
+	for _, value := range values {
+		if err := t.inst.PushValue(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PushValueDropFront pushes the value, popping and discarding values from the
+// front while the queue is full, all under one lock. The front value is the
+// oldest one of a FIFO, but the next one to pop of a priority queue, which is
+// not the oldest. A full queue without any value can not take it, so the new
+// value is discarded instead.
+func (t *GenericSafetyQueue[T]) PushValueDropFront(value T) error {
+	return t.executePushMethod(func() error {
+		for {
+			if err := t.inst.PushValue(value); !errors.Is(err, ErrFull) {
+				return err
+			}
+			if _, ok := t.inst.PopValue(); !ok {
+				return nil
+			}
+		}
+	})
+}
+
+// restoreValue puts back a value popped from the front of the queue. It needs a
+// queue that can push to the front and fails if the queue is full or has
+// already been closed and drained.
+func (t *GenericSafetyQueue[T]) restoreValue(value T) (retErr error) {
+	t.ExecuteWriteMethod(func() {
+		if t.closeState.closed && t.inst.IsEmpty() {
+			retErr = ErrClosed
+			return
+		}
+		switch inst := t.inst.(type) {
+		case interface{ PushValueToFront(value T) error }:
+			retErr = inst.PushValueToFront(value)
+		case GenericIndexable[T]:
+			retErr = inst.InsertAt(0, value)
+		default:
+			retErr = ErrUnsupportedOperation
+		}
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PopValue() (retValue T, retOk bool) {
+	t.ExecuteWriteMethod(func() {
+		retValue, retOk = t.inst.PopValue()
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PopValueWait(ctx context.Context) (T, error) {
+	return t.popValueWait(ctx, t.inst.PopValue)
+}
+
+func (t *GenericSafetyQueue[T]) PopValueWaitTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return t.PopValueWait(ctx)
+}
+
+func (t *GenericSafetyQueue[T]) PopValues(count int) (retValues []T) {
+	t.ExecuteWriteMethod(func() {
+		retValues = popValues(count, t.inst.PopValue)
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	t.ExecuteWriteMethod(func() {
+		retCount, retErr = popValuesToListSpace(ptrListSpace, t.inst.PopValue)
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PopValuesWithFilterFunction(f func(value T) bool) (retErr error) {
+	t.ExecuteWriteMethod(func() {
+		retErr = popValuesWithFilterFunction(f, t.inst.PopValue)
+	})
+	return
+}
+
+// The peek methods report no values if the wrapped queue is not
+// GenericPeekable, such as an MPMCRingQueue, the same way the index and scan
+// methods of SafetyRingQueue return ErrUnsupportedOperation. Check
+// GetQueueInstance to tell such a queue from an empty one.
+func (t *GenericSafetyQueue[T]) PeekValue() (retValue T, retOk bool) {
+	t.ExecuteReadMethod(func() {
+		if inst, ok := t.inst.(GenericPeekable[T]); ok {
+			retValue, retOk = inst.PeekValue()
+		}
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PeekValues(count int) (retValues []T) {
+	t.ExecuteReadMethod(func() {
+		if inst, ok := t.inst.(GenericPeekable[T]); ok {
+			retValues = inst.PeekValues(count)
+		}
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PeekFront() (retValue T, retOk bool) {
+	t.ExecuteReadMethod(func() {
+		if inst, ok := t.inst.(GenericPeekable[T]); ok {
+			retValue, retOk = inst.PeekFront()
+		}
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PeekBack() (retValue T, retOk bool) {
+	t.ExecuteReadMethod(func() {
+		if inst, ok := t.inst.(GenericPeekable[T]); ok {
+			retValue, retOk = inst.PeekBack()
+		}
+	})
+	return
+}
+
+func (t *GenericSafetyQueue[T]) PeekAt(index int) (retValue T, retOk bool) {
+	t.ExecuteReadMethod(func() {
+		if inst, ok := t.inst.(GenericPeekable[T]); ok {
+			retValue, retOk = inst.PeekAt(index)
+		}
+	})
+	return
+}
+
+// pushValueWait retries pushFunc until it succeeds, fails with an error other
+// than ErrFull, the queue is closed or ctx is done.
+func (t *GenericSafetyQueue[T]) pushValueWait(ctx context.Context, pushFunc func() error) error {
+	for {
+		lengthBefore := t.lock()
+		if t.closeState.closed {
+			t.rwMutex.Unlock()
+			return ErrClosed
+		}
+		if err := pushFunc(); !errors.Is(err, ErrFull) {
+			t.notifyWaiters(lengthBefore)
+			t.unlock()
+			return err
+		}
+		waitCh := t.notFullNotifier.waitChan()
+		t.waiterCount.Add(1)
+		t.rwMutex.Unlock()
+
+		err := waitNotification(ctx, waitCh)
+		t.waiterCount.Add(-1)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// popValueWait retries popFunc until it returns a value, the queue is closed
+// and drained or ctx is done.
+func (t *GenericSafetyQueue[T]) popValueWait(ctx context.Context, popFunc func() (T, bool)) (T, error) {
+	for {
+		lengthBefore := t.lock()
+		if value, ok := popFunc(); ok {
+			t.notifyWaiters(lengthBefore)
+			t.rwMutex.Unlock()
+			return value, nil
+		}
+		if t.closeState.closed {
+			t.rwMutex.Unlock()
+			var zero T
+			return zero, ErrClosed
+		}
+		waitCh := t.notEmptyNotifier.waitChan()
+		t.waiterCount.Add(1)
+		t.rwMutex.Unlock()
+
+		err := waitNotification(ctx, waitCh)
+		t.waiterCount.Add(-1)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+}
+
+func (t *GenericSafetyQueue[T]) ExecuteWriteMethod(f func()) {
+	lengthBefore := t.lock()
+	defer t.unlock()
+	defer t.notifyWaiters(lengthBefore)
+	f()
+}
+
+func (t *GenericSafetyQueue[T]) ExecuteReadMethod(f func()) {
+	t.rwMutex.RLock()
+	defer t.rwMutex.RUnlock()
+	f()
+}
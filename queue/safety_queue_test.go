@@ -0,0 +1,238 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// waitForWaiters returns once count goroutines are blocked in a Wait method of
+// q, and fails the test if that takes too long.
+func waitForWaiters[T any](tb testing.TB, q *GenericSafetyQueue[T], count int) {
+	tb.Helper()
+	deadline := time.Now().Add(time.Second * 5)
+	for q.waiterCount.Load() < int64(count) {
+		if time.Now().After(deadline) {
+			tb.Fatalf("Timed out waiting for %d waiters, %d are blocked", count, q.waiterCount.Load())
+		}
+		runtime.Gosched()
+	}
+}
+
+// isNotified reports whether the channel a notifier handed to its waiters has
+// been closed.
+func isNotified[T any](q *GenericSafetyQueue[T], ch <-chan struct{}) (retNotified bool) {
+	q.ExecuteReadMethod(func() {
+		select {
+		case <-ch:
+			retNotified = true
+		default:
+		}
+	})
+	return
+}
+
+func TestSafetyQueueNotifyWaiters_1(t *testing.T) {
+	safetyQueue, newQueueErr := NewGenericSafetyQueue[int](func() GenericFIFO[int] {
+		return NewGenericRingQueue[int](2)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety queue, %v", newQueueErr)
+		return
+	}
+
+	// Writes that add no value must not wake the poppers
+	popErrCh := make(chan error, 1)
+	go func() {
+		_, err := safetyQueue.PopValueWait(context.Background())
+		popErrCh <- err
+	}()
+	waitForWaiters(t, safetyQueue, 1)
+	var notEmptyCh <-chan struct{}
+	safetyQueue.ExecuteReadMethod(func() {
+		notEmptyCh = safetyQueue.notEmptyNotifier.ch
+	})
+	safetyQueue.PopValue()
+	safetyQueue.PopValues(1)
+	safetyQueue.ExecuteWriteMethod(func() {})
+	if isNotified(safetyQueue, notEmptyCh) {
+		t.Error("A write that added no value woke the poppers")
+		return
+	}
+	if err := safetyQueue.PushValue(0); err != nil {
+		t.Errorf("Failed to push the value, %v", err)
+		return
+	}
+	if err := <-popErrCh; err != nil {
+		t.Errorf("The blocked popper did not get the pushed value, %v", err)
+		return
+	}
+
+	// Writes that free no room must not wake the pushers
+	if err := safetyQueue.PushValue(1); err != nil {
+		t.Errorf("Failed to push the value, %v", err)
+		return
+	}
+	pushErrCh := make(chan error, 1)
+	go func() {
+		pushErrCh <- safetyQueue.PushValueWait(context.Background(), 2)
+	}()
+	waitForWaiters(t, safetyQueue, 1)
+	var notFullCh <-chan struct{}
+	safetyQueue.ExecuteReadMethod(func() {
+		notFullCh = safetyQueue.notFullNotifier.ch
+	})
+	if err := safetyQueue.PushValue(3); err == nil {
+		t.Error("Pushing to a full queue succeeded")
+		return
+	}
+	if isNotified(safetyQueue, notFullCh) {
+		t.Error("A write that freed no room woke the pushers")
+		return
+	}
+	if value, ok := safetyQueue.PopValue(); !ok || value != 1 {
+		t.Error("The pop-up value does not match the result")
+		return
+	}
+	if err := <-pushErrCh; err != nil {
+		t.Errorf("The blocked pusher was not woken by the pop, %v", err)
+		return
+	}
+}
+
+func TestSafetyRingQueueClose_2(t *testing.T) {
+	safetyQueue, newQueueErr := NewSafetyRingDeque(func() IRingQueue {
+		return NewRingQueue(2)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	waiterNum := 4
+	errCh := make(chan error, waiterNum)
+	var wg sync.WaitGroup
+	for i := 0; i < waiterNum; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := safetyQueue.PopValueWait(context.Background())
+			errCh <- err
+		}()
+	}
+
+	waitForWaiters(t, &safetyQueue.SafetyQueue, waiterNum)
+	if err := safetyQueue.Close(); err != nil {
+		t.Errorf("Failed to close the ring queue, %v", err)
+		return
+	}
+	wg.Wait()
+	close(errCh)
+	if safetyQueue.waiterCount.Load() != 0 {
+		t.Error("Woken waiters are still counted")
+		return
+	}
+
+	for err := range errCh {
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("The blocked waiter was not woken with ErrClosed, %v", err)
+			return
+		}
+	}
+
+	select {
+	case <-safetyQueue.Done():
+	default:
+		t.Error("The done channel of an empty closed queue was not closed")
+		return
+	}
+}
+
+func TestSafetyDequeClose_3(t *testing.T) {
+	safetyQueue, newQueueErr := NewSafetyDeque(func() IDeque {
+		return NewLinkListDeque(1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	if err := safetyQueue.PushValueToBack(0); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+
+	pushErrCh := make(chan error, 1)
+	go func() {
+		pushErrCh <- safetyQueue.PushValueToFrontWait(context.Background(), 1)
+	}()
+
+	waitForWaiters(t, &safetyQueue.SafetyQueue, 1)
+	if err := safetyQueue.Close(); err != nil {
+		t.Errorf("Failed to close the dual end queue, %v", err)
+		return
+	}
+	if err := <-pushErrCh; !errors.Is(err, ErrClosed) {
+		t.Errorf("The blocked producer was not woken with ErrClosed, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValuesToBack(1); !errors.Is(err, ErrClosed) {
+		t.Errorf("Pushing to a closed queue did not return ErrClosed, %v", err)
+		return
+	}
+
+	value, err := safetyQueue.PopValueFromBackWait(context.Background())
+	if err != nil || value != 0 {
+		t.Errorf("Failed to drain the closed dual end queue, %v", err)
+		return
+	}
+	if _, err := safetyQueue.PopValueFromFrontWait(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Errorf("Popping from a drained queue did not return ErrClosed, %v", err)
+		return
+	}
+
+	select {
+	case <-safetyQueue.Done():
+	default:
+		t.Error("The done channel was not closed after the queue was drained")
+		return
+	}
+}
+
+func TestSafetyRingQueueResize_1(t *testing.T) {
+	safetyQueue, newQueueErr := NewSafetyRingDeque(func() IRingQueue {
+		return NewRingQueue(3)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValues(0, 1); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	// Growing the queue wakes a producer blocked on the full queue
+	pushErrCh := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		pushErrCh <- safetyQueue.PushValueWait(ctx, 2)
+	}()
+	waitForWaiters(t, &safetyQueue.SafetyQueue, 1)
+	if err := safetyQueue.Resize(8); err != nil {
+		t.Errorf("Failed to resize the ring queue, %v", err)
+		return
+	}
+	if err := <-pushErrCh; err != nil {
+		t.Errorf("Failed to push the value after resizing, %v", err)
+		return
+	}
+	if safetyQueue.GetLength() != 3 {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+}
@@ -1,151 +1,169 @@
 package queue
 
-import (
-	"errors"
-	"sync"
-)
-
-type IRingQueue interface {
-	GetLength() int
-	IsEmpty() bool
-	IsFull() bool
-	GetAvailableCapacitySize() int
-	PushValue(value interface{}) error
-	PushValues(values ...interface{}) error
-	PopValue() (interface{}, bool)
-	PopValues(count int) (retValues []interface{})
-	PopValuesToListSpace(ptrListSpace *[]any) (retCount int, retErr error)
-	PopValuesWithFilterFunction(f func(value interface{}) bool) (retErr error)
-}
+type IRingQueue = IGenericRingQueue[any]
 
 func NewSafetyRingDeque(newDequeFunc func() IRingQueue) (*SafetyRingQueue, error) {
 	inst := newDequeFunc()
 	if inst == nil {
-		return nil, errors.New("the created ring queue instance is a nil value")
+		return nil, ErrNilInstance
 	}
 
-	return &SafetyRingQueue{
+	t := &SafetyRingQueue{
 		inst: inst,
-	}, nil
+	}
+	t.init(inst)
+	return t, nil
 }
 
+// SafetyRingQueue is a SafetyQueue over a ring queue with the ring queue
+// specific methods on top.
 type SafetyRingQueue struct {
-	rwMutex sync.RWMutex
-	inst    IRingQueue
+	SafetyQueue
+	inst IRingQueue
 }
 
 func (t *SafetyRingQueue) GetQueueInstance() IRingQueue {
 	return t.inst
 }
 
-func (t *SafetyRingQueue) GetLength() int {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-	return t.inst.GetLength()
-}
-
-func (t *SafetyRingQueue) IsEmpty() bool {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-	return t.inst.IsEmpty()
-}
-
-func (t *SafetyRingQueue) IsFull() bool {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-	return t.inst.IsFull()
-}
-
-func (t *SafetyRingQueue) GetAvailableCapacitySize() int {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-	return t.inst.GetAvailableCapacitySize()
-}
-
-func (t *SafetyRingQueue) PushValue(value interface{}) error {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PushValue(value)
-}
-
 func (t *SafetyRingQueue) PushValueAndRetLength(value interface{}) (retLen int, retErr error) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-
-	if retErr = t.inst.PushValue(value); retErr != nil {
-		return
-	}
-	retLen = t.inst.GetLength()
+	retErr = t.executePushMethod(func() error {
+		if err := t.inst.PushValue(value); err != nil {
+			return err
+		}
+		retLen = t.inst.GetLength()
+		return nil
+	})
 	return
 }
 
-func (t *SafetyRingQueue) PushValues(values ...interface{}) error {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PushValues(values...)
-}
-
 func (t *SafetyRingQueue) PushValuesAndRetLength(values ...interface{}) (retLen int, retErr error) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-
-	if retErr = t.inst.PushValues(values...); retErr != nil {
-		return
-	}
-	retLen = t.inst.GetLength()
+	retErr = t.executePushMethod(func() error {
+		if err := t.inst.PushValues(values...); err != nil {
+			return err
+		}
+		retLen = t.inst.GetLength()
+		return nil
+	})
 	return
 }
 
-func (t *SafetyRingQueue) PopValue() (interface{}, bool) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PopValue()
+func (t *SafetyRingQueue) PopValueAndRetLength() (retVal interface{}, retOk bool, retLen int) {
+	t.ExecuteWriteMethod(func() {
+		retVal, retOk = t.inst.PopValue()
+		retLen = t.inst.GetLength()
+	})
+	return
 }
 
-func (t *SafetyRingQueue) PopValueAndRetLength() (retVal interface{}, retOk bool, retLen int) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
+func (t *SafetyRingQueue) PopValuesAndRetLength(count int) (retValues []interface{}, retLen int) {
+	t.ExecuteWriteMethod(func() {
+		retValues = t.inst.PopValues(count)
+		retLen = t.inst.GetLength()
+	})
+	return
+}
 
-	retVal, retOk = t.inst.PopValue()
-	retLen = t.inst.GetLength()
+// The index based methods return ErrUnsupportedOperation if the wrapped queue
+// lacks the method, Set and InsertAt need an Indexable queue such as a
+// RingQueue.
+func (t *SafetyRingQueue) Get(index int) (retValue interface{}, retErr error) {
+	t.ExecuteReadMethod(func() {
+		inst, ok := t.inst.(interface{ Get(index int) (any, error) })
+		if !ok {
+			retErr = ErrUnsupportedOperation
+			return
+		}
+		retValue, retErr = inst.Get(index)
+	})
 	return
 }
 
-func (t *SafetyRingQueue) PopValues(count int) (retValues []interface{}) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PopValues(count)
+func (t *SafetyRingQueue) Set(index int, value interface{}) (retErr error) {
+	t.ExecuteWriteMethod(func() {
+		inst, ok := t.inst.(Indexable)
+		if !ok {
+			retErr = ErrUnsupportedOperation
+			return
+		}
+		retErr = inst.Set(index, value)
+	})
+	return
 }
 
-func (t *SafetyRingQueue) PopValuesAndRetLength(count int) (retValues []interface{}, retLen int) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
+func (t *SafetyRingQueue) RemoveAt(index int) (retValue interface{}, retErr error) {
+	t.ExecuteWriteMethod(func() {
+		inst, ok := t.inst.(interface{ RemoveAt(index int) (any, error) })
+		if !ok {
+			retErr = ErrUnsupportedOperation
+			return
+		}
+		retValue, retErr = inst.RemoveAt(index)
+	})
+	return
+}
 
-	retValues = t.inst.PopValues(count)
-	retLen = t.inst.GetLength()
+func (t *SafetyRingQueue) InsertAt(index int, value interface{}) error {
+	return t.executePushMethod(func() error {
+		inst, ok := t.inst.(Indexable)
+		if !ok {
+			return ErrUnsupportedOperation
+		}
+		return inst.InsertAt(index, value)
+	})
+}
+
+// Resize resizes the wrapped queue if it supports resizing, otherwise it
+// returns ErrUnsupportedOperation. A resize may make room without changing the
+// length, so it wakes the pushers waiting for room.
+func (t *SafetyRingQueue) Resize(newCapacity int) (retErr error) {
+	t.ExecuteWriteMethod(func() {
+		inst, ok := t.inst.(interface{ Resize(newCapacity int) error })
+		if !ok {
+			retErr = ErrUnsupportedOperation
+			return
+		}
+		if retErr = inst.Resize(newCapacity); retErr == nil {
+			t.notFullNotifier.notify()
+		}
+	})
 	return
 }
 
-func (t *SafetyRingQueue) PopValuesToListSpace(ptrListSpace *[]any) (retCount int, retErr error) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PopValuesToListSpace(ptrListSpace)
+// ScanElements holds the read lock while f runs, so f must not call the write
+// methods of the queue. The scan methods return ErrUnsupportedOperation if the
+// wrapped queue does not implement Scannable.
+func (t *SafetyRingQueue) ScanElements(f func(value interface{}) bool) (retErr error) {
+	t.ExecuteReadMethod(func() {
+		retErr = t.scannable(func(inst Scannable) error {
+			return inst.ScanElements(f)
+		})
+	})
+	return
 }
 
-func (t *SafetyRingQueue) PopValuesWithFilterFunction(f func(value interface{}) bool) (retErr error) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	return t.inst.PopValuesWithFilterFunction(f)
+func (t *SafetyRingQueue) ScanElementsReverse(f func(value interface{}) bool) (retErr error) {
+	t.ExecuteReadMethod(func() {
+		retErr = t.scannable(func(inst Scannable) error {
+			return inst.ScanElementsReverse(f)
+		})
+	})
+	return
 }
 
-func (t *SafetyRingQueue) ExecuteWriteMethod(f func()) {
-	t.rwMutex.Lock()
-	defer t.rwMutex.Unlock()
-	f()
+func (t *SafetyRingQueue) ScanElementsWithIndex(f func(index int, value interface{}) bool) (retErr error) {
+	t.ExecuteReadMethod(func() {
+		retErr = t.scannable(func(inst Scannable) error {
+			return inst.ScanElementsWithIndex(f)
+		})
+	})
+	return
 }
 
-func (t *SafetyRingQueue) ExecuteReadMethod(f func()) {
-	t.rwMutex.RLock()
-	defer t.rwMutex.RUnlock()
-	f()
+func (t *SafetyRingQueue) scannable(scanFunc func(inst Scannable) error) error {
+	inst, ok := t.inst.(Scannable)
+	if !ok {
+		return ErrUnsupportedOperation
+	}
+	return scanFunc(inst)
 }
@@ -0,0 +1,242 @@
+package queue
+
+type SPSCRingQueue = GenericSPSCRingQueue[any]
+
+func NewSPSCRingQueue(capacity int) *SPSCRingQueue {
+	return NewGenericSPSCRingQueue[any](capacity)
+}
+
+// GenericSPSCRingQueue is a lock-free ring queue for exactly one producer and
+// one consumer goroutine. The push methods may only be called by the producer
+// and the pop, peek, Get and scan methods only by the consumer, the length
+// queries are safe from either side but may be stale. Values can not be set,
+// inserted or removed by index, so it does not implement GenericIndexable.
+type GenericSPSCRingQueue[T any] struct {
+	_          cacheLinePad
+	head       paddedUint64
+	cachedTail uint64
+	_          cacheLinePad
+	tail       paddedUint64
+	cachedHead uint64
+	_          cacheLinePad
+	capacity   uint64
+	mask       uint64
+	values     []T
+}
+
+func NewGenericSPSCRingQueue[T any](capacity int) *GenericSPSCRingQueue[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	size := roundUpPowerOfTwo(capacity)
+	return &GenericSPSCRingQueue[T]{
+		capacity: uint64(capacity),
+		mask:     uint64(size - 1),
+		values:   make([]T, size),
+	}
+}
+
+func (t *GenericSPSCRingQueue[T]) GetLength() int {
+	head := t.head.Load()
+	tail := t.tail.Load()
+	length := tail - head
+	if length > t.capacity {
+		length = t.capacity
+	}
+	return int(length)
+}
+
+func (t *GenericSPSCRingQueue[T]) IsEmpty() bool {
+	return t.GetLength() == 0
+}
+
+func (t *GenericSPSCRingQueue[T]) IsFull() bool {
+	return t.GetLength() >= int(t.capacity)
+}
+
+func (t *GenericSPSCRingQueue[T]) GetAvailableCapacitySize() int {
+	return int(t.capacity) - t.GetLength()
+}
+
+func (t *GenericSPSCRingQueue[T]) PushValue(value T) error {
+	tail := t.tail.Load()
+	if tail-t.cachedHead >= t.capacity {
+		t.cachedHead = t.head.Load()
+		if tail-t.cachedHead >= t.capacity {
+			return ErrFull
+		}
+	}
+
+	t.values[tail&t.mask] = value
+	t.tail.Store(tail + 1)
+	return nil
+}
+
+// PushValues publishes the whole batch to the consumer at once.
+func (t *GenericSPSCRingQueue[T]) PushValues(values ...T) error {
+	valuesLen := uint64(len(values))
+	if valuesLen <= 0 {
+		return nil
+	}
+
+	tail := t.tail.Load()
+	if tail+valuesLen-t.cachedHead > t.capacity {
+		t.cachedHead = t.head.Load()
+		if available := t.capacity - (tail - t.cachedHead); valuesLen > available {
+			return newCapacityError(len(values), int(available))
+		}
+	}
+
+	for i, value := range values {
+		t.values[(tail+uint64(i))&t.mask] = value
+	}
+	t.tail.Store(tail + valuesLen)
+	return nil
+}
+
+func (t *GenericSPSCRingQueue[T]) PushValuesWithoutCheck(values ...T) (retPushedCount int) {
+	for _, value := range values {
+		if err := t.PushValue(value); err != nil {
+			return
+		}
+		retPushedCount += 1
+	}
+
+	return
+}
+
+func (t *GenericSPSCRingQueue[T]) PopValue() (T, bool) {
+	var zero T
+	head := t.head.Load()
+	if head == t.cachedTail {
+		t.cachedTail = t.tail.Load()
+		if head == t.cachedTail {
+			return zero, false
+		}
+	}
+
+	idx := head & t.mask
+	retValue := t.values[idx]
+	t.values[idx] = zero
+	t.head.Store(head + 1)
+	return retValue, true
+}
+
+func (t *GenericSPSCRingQueue[T]) PopValues(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericSPSCRingQueue[T]) PopValuesToListSpace(ptrListSpace *[]T) (retCount int, retErr error) {
+	return popValuesToListSpace(ptrListSpace, t.PopValue)
+}
+
+func (t *GenericSPSCRingQueue[T]) PopValuesWithFilterFunction(f func(value T) bool) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := t.PopValue()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
+
+func (t *GenericSPSCRingQueue[T]) PeekValue() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericSPSCRingQueue[T]) PeekValues(count int) (retValues []T) {
+	valuesLen := t.GetLength()
+	if count > valuesLen {
+		count = valuesLen
+	}
+
+	for i := 0; i < count; i++ {
+		value, _ := t.PeekAt(i)
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericSPSCRingQueue[T]) PeekFront() (T, bool) {
+	return t.PeekAt(0)
+}
+
+func (t *GenericSPSCRingQueue[T]) PeekBack() (T, bool) {
+	return t.PeekAt(t.GetLength() - 1)
+}
+
+func (t *GenericSPSCRingQueue[T]) PeekAt(index int) (T, bool) {
+	head := t.head.Load()
+	if index < 0 || uint64(index) >= t.tail.Load()-head {
+		var zero T
+		return zero, false
+	}
+
+	return t.values[(head+uint64(index))&t.mask], true
+}
+
+func (t *GenericSPSCRingQueue[T]) Get(index int) (T, error) {
+	value, ok := t.PeekAt(index)
+	if !ok {
+		return value, newIndexError(index, t.GetLength())
+	}
+
+	return value, nil
+}
+
+func (t *GenericSPSCRingQueue[T]) ScanElements(f func(value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	return t.ScanElementsWithIndex(func(index int, value T) bool {
+		return f(value)
+	})
+}
+
+func (t *GenericSPSCRingQueue[T]) ScanElementsReverse(f func(value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	head := t.head.Load()
+	for pos := t.tail.Load(); pos > head; pos-- {
+		if !f(t.values[(pos-1)&t.mask]) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericSPSCRingQueue[T]) ScanElementsWithIndex(f func(index int, value T) bool) error {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	head := t.head.Load()
+	tail := t.tail.Load()
+	for pos := head; pos < tail; pos++ {
+		if !f(int(pos-head), t.values[pos&t.mask]) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package queue
+
+func popValuesToListSpace[T any](ptrListSpace *[]T, popFunc func() (T, bool)) (retCount int, retErr error) {
+	if ptrListSpace == nil {
+		retErr = ErrNilListSpace
+		return
+	}
+
+	listSpace := *ptrListSpace
+	listSpaceLen := len(listSpace)
+	if listSpaceLen > 0 {
+		for i := 0; i < listSpaceLen; i++ {
+			val, valid := popFunc()
+			if !valid {
+				return
+			}
+
+			listSpace[i] = val
+			retCount += 1
+		}
+		return
+	}
+
+	listSpaceCap := cap(listSpace)
+	if listSpaceCap <= 0 {
+		retErr = ErrZeroCapListSpace
+		return
+	}
+
+	for i := 0; i < listSpaceCap; i++ {
+		val, valid := popFunc()
+		if !valid {
+			return
+		}
+
+		*ptrListSpace = append(*ptrListSpace, val)
+		retCount += 1
+	}
+
+	return
+}
+
+func popValues[T any](count int, popFunc func() (T, bool)) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := popFunc()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func popValuesWithFilterFunction[T any](f func(value T) bool, popFunc func() (T, bool)) (retErr error) {
+	if f == nil {
+		return ErrNilFunc
+	}
+
+	for {
+		value, valid := popFunc()
+		if !valid {
+			return
+		}
+		if !f(value) {
+			return
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package queue
+
+import (
+	"sync/atomic"
+)
+
+const (
+	minWorkStealingBufferSize = 32
+)
+
+type WorkStealingDeque = GenericWorkStealingDeque[any]
+
+func NewWorkStealingDeque() *WorkStealingDeque {
+	return NewGenericWorkStealingDeque[any]()
+}
+
+type workStealingBuffer[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+func newWorkStealingBuffer[T any](size int) *workStealingBuffer[T] {
+	return &workStealingBuffer[T]{
+		slots: make([]atomic.Pointer[T], size),
+		mask:  int64(size - 1),
+	}
+}
+
+func (t *workStealingBuffer[T]) slot(index int64) *atomic.Pointer[T] {
+	return &t.slots[index&t.mask]
+}
+
+func (t *workStealingBuffer[T]) grow(top, bottom int64) *workStealingBuffer[T] {
+	buffer := newWorkStealingBuffer[T](len(t.slots) << 1)
+	for i := top; i < bottom; i++ {
+		buffer.slot(i).Store(t.slot(i).Load())
+	}
+	return buffer
+}
+
+// GenericWorkStealingDeque is an unbounded Chase-Lev deque. In the IDeque
+// vocabulary its back belongs to a single owner goroutine, which is the only
+// one allowed to call PushValueToBack and PopValueFromBack, while any number of
+// thieves may take values from the front with StealValueFromFront.
+//
+// Each value is boxed when it is pushed, so storing pointers avoids a copy.
+type GenericWorkStealingDeque[T any] struct {
+	_      cacheLinePad
+	top    atomic.Int64
+	_      cacheLinePad
+	bottom atomic.Int64
+	_      cacheLinePad
+	buffer atomic.Pointer[workStealingBuffer[T]]
+}
+
+func NewGenericWorkStealingDeque[T any]() *GenericWorkStealingDeque[T] {
+	t := &GenericWorkStealingDeque[T]{}
+	t.buffer.Store(newWorkStealingBuffer[T](minWorkStealingBufferSize))
+	return t
+}
+
+// GetLength is a snapshot that may already be stale when it returns.
+func (t *GenericWorkStealingDeque[T]) GetLength() int {
+	length := t.bottom.Load() - t.top.Load()
+	if length < 0 {
+		return 0
+	}
+	return int(length)
+}
+
+func (t *GenericWorkStealingDeque[T]) IsEmpty() bool {
+	return t.GetLength() == 0
+}
+
+func (t *GenericWorkStealingDeque[T]) PushValueToBack(value T) error {
+	bottom := t.bottom.Load()
+	top := t.top.Load()
+	buffer := t.buffer.Load()
+	if bottom-top >= int64(len(buffer.slots)) {
+		buffer = buffer.grow(top, bottom)
+		t.buffer.Store(buffer)
+	}
+
+	buffer.slot(bottom).Store(&value)
+	t.bottom.Store(bottom + 1)
+	return nil
+}
+
+func (t *GenericWorkStealingDeque[T]) PushValuesToBack(values ...T) error {
+	for _, value := range values {
+		if err := t.PushValueToBack(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *GenericWorkStealingDeque[T]) PopValueFromBack() (T, bool) {
+	var zero T
+	bottom := t.bottom.Load() - 1
+	buffer := t.buffer.Load()
+	t.bottom.Store(bottom)
+
+	top := t.top.Load()
+	if top > bottom {
+		t.bottom.Store(bottom + 1)
+		return zero, false
+	}
+
+	ptrValue := buffer.slot(bottom).Load()
+	if top < bottom {
+		// No thief can reach this slot any more once the bottom has moved
+		buffer.slot(bottom).Store(nil)
+		return *ptrValue, true
+	}
+
+	// The last value, race the thieves for it
+	won := t.top.CompareAndSwap(top, top+1)
+	t.bottom.Store(bottom + 1)
+	if !won {
+		return zero, false
+	}
+	buffer.slot(bottom).CompareAndSwap(ptrValue, nil)
+	return *ptrValue, true
+}
+
+func (t *GenericWorkStealingDeque[T]) PopValuesFromBack(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.PopValueFromBack()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
+
+func (t *GenericWorkStealingDeque[T]) StealValueFromFront() (T, bool) {
+	var zero T
+	for {
+		top := t.top.Load()
+		bottom := t.bottom.Load()
+		if top >= bottom {
+			return zero, false
+		}
+
+		buffer := t.buffer.Load()
+		ptrValue := buffer.slot(top).Load()
+		if !t.top.CompareAndSwap(top, top+1) {
+			continue
+		}
+
+		buffer.slot(top).CompareAndSwap(ptrValue, nil)
+		return *ptrValue, true
+	}
+}
+
+func (t *GenericWorkStealingDeque[T]) StealValuesFromFront(count int) (retValues []T) {
+	for i := 0; i < count; i++ {
+		value, valid := t.StealValueFromFront()
+		if !valid {
+			return
+		}
+		retValues = append(retValues, value)
+	}
+
+	return
+}
@@ -265,3 +265,53 @@ func TestSafetyLinkListDequePushValues_5(t *testing.T) {
 		return
 	}
 }
+
+// back - back last-in first-out into an empty list space with spare capacity
+func TestSafetyLinkListDequePushValues_6(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewLinkListDeque(-1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	var elemValues []interface{}
+	for i := 0; i < dequeElemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := safetyQueue.PushValuesToBack(elemValues...); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+
+	oncePopCount := dequeElemValuesLen / 3
+	popListSpace := make([]interface{}, 0, oncePopCount)
+	poppedCount, popErr := safetyQueue.PopValuesFromBackToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	if poppedCount != oncePopCount {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+
+	for idx, v := range popListSpace {
+		if v != elemValues[dequeElemValuesLen-idx-1] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	frontValue, ok := safetyQueue.PeekFront()
+	if !ok || frontValue != elemValues[0] {
+		t.Error("Popping from the back removed values from the front")
+		return
+	}
+	if safetyQueue.GetLength() != dequeElemValuesLen-oncePopCount {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+}
@@ -0,0 +1,203 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func TestGenericLinkListDequePushValues_1(t *testing.T) {
+	var deque queue.IGenericDeque[int] = queue.NewGenericLinkListDeque[int](dequeCapacitySize)
+
+	var elemValues []int
+	for i := 0; i < dequeCapacitySize; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := deque.PushValuesToBack(elemValues...); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+	if err := deque.PushValueToFront(0); err == nil {
+		t.Error("Exceeding capacity size without throwing an error")
+		return
+	}
+
+	for i := 0; i < dequeCapacitySize/2; i++ {
+		frontVal, frontOk := deque.PopValueFromFront()
+		backVal, backOk := deque.PopValueFromBack()
+		if !frontOk || !backOk || frontVal != elemValues[i] || backVal != elemValues[dequeCapacitySize-i-1] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if !deque.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestGenericLinkListDequePushValues_2(t *testing.T) {
+	deque := queue.NewGenericLinkListDeque[int](-1)
+
+	var elemValues []int
+	for i := 0; i < dequeElemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := deque.PushValuesToBack(elemValues...); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+
+	popListSpace := make([]int, 0, dequeElemValuesLen)
+	poppedCount, popErr := deque.PopValuesFromBackToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	if poppedCount != dequeElemValuesLen {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+
+	for idx, v := range popListSpace {
+		if v != elemValues[dequeElemValuesLen-idx-1] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
+
+func TestGenericLinkListDequeRemoveElements_3(t *testing.T) {
+	deque := queue.NewGenericLinkListDeque[int](-1)
+
+	for round := 0; round < 2; round++ {
+		for i := 0; i < dequeElemValuesLen; i++ {
+			if err := deque.PushValueToBack(i); err != nil {
+				t.Errorf("Failed to push the value to queue back, %v", err)
+				return
+			}
+		}
+
+		var removeNodes []*queue.LinkListNode[int]
+		deque.ScanElementsFromFront(func(node *queue.LinkListNode[int]) bool {
+			if node.Value%2 == 0 {
+				removeNodes = append(removeNodes, node)
+			}
+			return true
+		})
+		deque.RemoveElements(removeNodes)
+
+		if deque.GetLength() != dequeElemValuesLen/2 {
+			t.Error("Wrong number of remaining elements")
+			return
+		}
+
+		expectedValue := dequeElemValuesLen - 1
+		deque.ScanElementsFromBack(func(node *queue.LinkListNode[int]) bool {
+			if node.Value != expectedValue {
+				t.Error("The scanned value does not match the result")
+				return false
+			}
+			expectedValue -= 2
+			return true
+		})
+
+		deque.PopValuesFromFront(dequeElemValuesLen)
+		if !deque.IsEmpty() {
+			t.Error("Not all queue elements popped up")
+			return
+		}
+	}
+}
+
+func TestGenericLinkListDequeRemoveElements_4(t *testing.T) {
+	deque := queue.NewGenericLinkListDeque[int](-1)
+
+	for i := 0; i < dequeElemValuesLen; i++ {
+		if err := deque.PushValueToBack(i); err != nil {
+			t.Errorf("Failed to push the value to queue back, %v", err)
+			return
+		}
+	}
+
+	var staleNodes []*queue.LinkListNode[int]
+	deque.ScanElementsFromFront(func(node *queue.LinkListNode[int]) bool {
+		staleNodes = append(staleNodes, node)
+		return true
+	})
+	deque.PopValuesFromFront(dequeElemValuesLen)
+
+	for i := 0; i < dequeElemValuesLen; i++ {
+		if err := deque.PushValueToBack(dequeElemValuesLen + i); err != nil {
+			t.Errorf("Failed to push the value to queue back, %v", err)
+			return
+		}
+	}
+
+	deque.RemoveElements(staleNodes)
+	if deque.GetLength() != dequeElemValuesLen {
+		t.Error("Stale nodes removed live elements")
+		return
+	}
+
+	for idx, node := range staleNodes {
+		if node.Next() != nil || node.Prev() != nil {
+			t.Errorf("Stale node %d is still linked", idx)
+			return
+		}
+	}
+}
+
+func TestGenericLinkListDequeRemoveElements_5(t *testing.T) {
+	deque := queue.NewGenericLinkListDeque[int](-1)
+	if err := deque.PushValuesToBack(0, 1, 2, 3); err != nil {
+		t.Errorf("Failed to push values to queue back, %v", err)
+		return
+	}
+
+	// Walk to the second node with Next, it must not be reused either
+	var first *queue.LinkListNode[int]
+	deque.ScanElementsFromFront(func(node *queue.LinkListNode[int]) bool {
+		first = node
+		return false
+	})
+	staleNode := first.Next()
+	deque.PopValuesFromFront(4)
+	if err := deque.PushValuesToBack(10, 11, 12, 13); err != nil {
+		t.Errorf("Failed to push values to queue back, %v", err)
+		return
+	}
+
+	deque.RemoveElements([]*queue.LinkListNode[int]{staleNode})
+	if values := deque.PeekValues(4); len(values) != 4 || values[2] != 12 {
+		t.Errorf("The stale node removed a live value, %v", values)
+		return
+	}
+
+	// Removing the following node while scanning skips it
+	var scannedValues []int
+	deque.ScanElementsFromFront(func(node *queue.LinkListNode[int]) bool {
+		scannedValues = append(scannedValues, node.Value)
+		if next := node.Next(); next != nil && next.Value == 11 {
+			deque.RemoveElements([]*queue.LinkListNode[int]{next})
+		}
+		return true
+	})
+	if len(scannedValues) != 3 || scannedValues[1] != 12 || deque.GetLength() != 3 {
+		t.Errorf("The scanned values do not match the result, %v", scannedValues)
+		return
+	}
+
+	deque.ScanElementsFromBack(func(node *queue.LinkListNode[int]) bool {
+		if prev := node.Prev(); prev != nil {
+			deque.RemoveElements([]*queue.LinkListNode[int]{node, prev})
+		}
+		return true
+	})
+	if values := deque.PeekValues(3); len(values) != 1 || values[0] != 10 {
+		t.Errorf("The remaining values do not match the result, %v", values)
+		return
+	}
+}
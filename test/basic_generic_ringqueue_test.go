@@ -0,0 +1,143 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+type ringQueueTestElem struct {
+	id   int
+	name string
+}
+
+func TestGenericRingQueuePushValues_1(t *testing.T) {
+	ringQueueCapacitySize := 50
+	ringQueueElemValuesLen := 30
+
+	var ringQueue queue.IGenericRingQueue[int] = queue.NewGenericRingQueue[int](ringQueueCapacitySize)
+
+	var elemValues []int
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := ringQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push the value to ring queue, %v", err)
+		return
+	}
+
+	poppedValues := ringQueue.PopValues(ringQueueElemValuesLen / 2)
+	for idx, v := range poppedValues {
+		if v != elemValues[idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	popListSpace := make([]int, 0, ringQueueElemValuesLen)
+	poppedCount, popErr := ringQueue.PopValuesToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	if poppedCount != ringQueueElemValuesLen-len(poppedValues) {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+	for idx, v := range popListSpace {
+		if v != elemValues[len(poppedValues)+idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if !ringQueue.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestGenericRingQueuePushValues_2(t *testing.T) {
+	ringQueueCapacitySize := 10
+
+	ringQueue := queue.NewGenericRingQueue[ringQueueTestElem](ringQueueCapacitySize)
+	for i := 0; i < ringQueueCapacitySize-1; i++ {
+		if err := ringQueue.PushValue(ringQueueTestElem{id: i, name: "elem"}); err != nil {
+			t.Errorf("Failed to push the value to ring queue, %v", err)
+			return
+		}
+	}
+	if err := ringQueue.PushValue(ringQueueTestElem{}); err == nil {
+		t.Error("Exceeding capacity size without throwing an error")
+		return
+	}
+
+	var poppedCount int
+	if err := ringQueue.PopValuesWithFilterFunction(func(value ringQueueTestElem) bool {
+		if value.id != poppedCount {
+			t.Error("The pop-up value does not match the result")
+			return false
+		}
+		poppedCount += 1
+		return true
+	}); err != nil {
+		t.Errorf("Failed to pop values to function, %v", err)
+		return
+	}
+	if poppedCount != ringQueueCapacitySize-1 {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+
+	ringQueue.ScanElements(func(value ringQueueTestElem) bool {
+		if value != (ringQueueTestElem{}) {
+			t.Error("There are still non empty elements in the queue")
+			return false
+		}
+		return true
+	})
+}
+
+func TestGenericRingQueuePushValues_3(t *testing.T) {
+	ringQueueCapacitySize := 8
+	ringQueue := queue.NewGenericRingQueue[int](ringQueueCapacitySize)
+
+	// A ring queue of capacity n holds n-1 values, so the available size must
+	// not count the slot that is kept empty
+	if ringQueue.GetAvailableCapacitySize() != ringQueueCapacitySize-1 {
+		t.Error("The available capacity size of an empty queue is incorrect")
+		return
+	}
+	if err := ringQueue.PushValues(0, 1, 2); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	availableCapSize := ringQueue.GetAvailableCapacitySize()
+	if availableCapSize != ringQueueCapacitySize-1-3 {
+		t.Error("The available capacity size is incorrect")
+		return
+	}
+
+	var values []int
+	for i := 0; i <= availableCapSize; i++ {
+		values = append(values, i)
+	}
+	if err := ringQueue.PushValues(values...); err == nil {
+		t.Error("Exceeding capacity size without throwing an error")
+		return
+	}
+	if ringQueue.GetLength() != 3 {
+		t.Error("A rejected batch push must not modify the queue")
+		return
+	}
+
+	if err := ringQueue.PushValues(values[:availableCapSize]...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if !ringQueue.IsFull() || ringQueue.GetAvailableCapacitySize() != 0 {
+		t.Error("The ring queue is not full after filling the available capacity")
+		return
+	}
+}
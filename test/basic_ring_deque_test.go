@@ -0,0 +1,131 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func TestSafetyRingDequePushValues_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewRingDeque(-1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	elemValuesLen := 1000
+	var elemValues []interface{}
+	for i := 0; i < elemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	// Interleave both ends so that the buffer wraps around before it grows
+	for i := 0; i < elemValuesLen/2; i++ {
+		if err := safetyQueue.PushValueToFront(elemValues[elemValuesLen/2-i-1]); err != nil {
+			t.Errorf("Failed to push the value to queue front, %v", err)
+			return
+		}
+		if err := safetyQueue.PushValueToBack(elemValues[elemValuesLen/2+i]); err != nil {
+			t.Errorf("Failed to push the value to queue back, %v", err)
+			return
+		}
+	}
+
+	poppedValues := safetyQueue.PopValuesFromFront(elemValuesLen / 2)
+	for idx, v := range poppedValues {
+		if v != elemValues[idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	poppedValues = safetyQueue.PopValuesFromBack(elemValuesLen / 2)
+	for idx, v := range poppedValues {
+		if v != elemValues[elemValuesLen-idx-1] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if safetyQueue.GetLength() > 0 {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestRingDequePushValuesWithException_2(t *testing.T) {
+	deque := queue.NewGenericRingDeque[int](dequeCapacitySize)
+
+	var elemValues []int
+	for i := 0; i < dequeCapacitySize+1; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := deque.PushValuesToBack(elemValues...); err == nil {
+		t.Error("Exceeding capacity size without throwing an error")
+		return
+	}
+
+	if pushedCount := deque.PushValuesToBackWithoutCheck(elemValues...); pushedCount != dequeCapacitySize {
+		t.Error("The amount of data pushed to the deque is incorrect")
+		return
+	}
+	if !deque.IsFull() || deque.GetAvailableCapacitySize() != 0 {
+		t.Error("The deque should be full")
+		return
+	}
+	if err := deque.PushValueToFront(0); err == nil {
+		t.Error("Exceeding capacity size without throwing an error")
+		return
+	}
+
+	popListSpace := make([]int, dequeCapacitySize)
+	poppedCount, popErr := deque.PopValuesFromBackToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	if poppedCount != dequeCapacitySize {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+	for idx, v := range popListSpace {
+		if v != elemValues[dequeCapacitySize-idx-1] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
+
+func TestShrinkableRingDequePushValues_3(t *testing.T) {
+	deque := queue.NewGenericShrinkableRingDeque[int](-1)
+
+	elemValuesLen := 4096
+	for round := 0; round < 3; round++ {
+		for i := 0; i < elemValuesLen; i++ {
+			if err := deque.PushValueToBack(i); err != nil {
+				t.Errorf("Failed to push the value to queue back, %v", err)
+				return
+			}
+		}
+
+		var poppedCount int
+		if err := deque.PopValuesFromFrontWithFilterFunction(func(value int) bool {
+			if value != poppedCount {
+				t.Error("The pop-up value does not match the result")
+				return false
+			}
+			poppedCount += 1
+			return true
+		}); err != nil {
+			t.Errorf("Failed to pop values to function, %v", err)
+			return
+		}
+
+		if poppedCount != elemValuesLen || !deque.IsEmpty() {
+			t.Error("Not all queue elements popped up")
+			return
+		}
+	}
+}
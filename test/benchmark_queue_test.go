@@ -0,0 +1,352 @@
+package test
+
+import (
+	"fmt"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	benchmarkBatchSize = 64
+)
+
+var (
+	benchmarkCapacitySizes = []int{16, 1024, 65536}
+	// benchmarkProducerConsumerRatios lists the producer and consumer counts of
+	// the parallel benchmarks.
+	benchmarkProducerConsumerRatios = [][2]int{{1, 1}, {1, 4}, {4, 1}, {4, 4}}
+)
+
+// benchmarkQueue gives every backend, including a plain channel, the same set
+// of operations, so each benchmark drives them through the same calls.
+type benchmarkQueue struct {
+	pushValue      func(value any) error
+	popValue       func() (any, bool)
+	pushValues     func(values ...any) error
+	popValues      func(count int) []any
+	popToListSpace func(ptrListSpace *[]any) (int, error)
+	filterPop      func(f func(value any) bool) error
+}
+
+func newRingQueueBenchmarkQueue(q queue.IRingQueue) *benchmarkQueue {
+	return &benchmarkQueue{
+		pushValue:      q.PushValue,
+		popValue:       q.PopValue,
+		pushValues:     q.PushValues,
+		popValues:      q.PopValues,
+		popToListSpace: q.PopValuesToListSpace,
+		filterPop:      q.PopValuesWithFilterFunction,
+	}
+}
+
+func newDequeBenchmarkQueue(d queue.IDeque) *benchmarkQueue {
+	return &benchmarkQueue{
+		pushValue:      d.PushValueToBack,
+		popValue:       d.PopValueFromFront,
+		pushValues:     d.PushValuesToBack,
+		popValues:      d.PopValuesFromFront,
+		popToListSpace: d.PopValuesFromFrontToListSpace,
+		filterPop:      d.PopValuesFromFrontWithFilterFunction,
+	}
+}
+
+func newLockFreeBenchmarkQueue(q *queue.LockFreeQueue) *benchmarkQueue {
+	return &benchmarkQueue{
+		pushValue:      q.PushValueToBack,
+		popValue:       q.PopValueFromFront,
+		pushValues:     q.PushValuesToBack,
+		popValues:      q.PopValuesFromFront,
+		popToListSpace: q.PopValuesFromFrontToListSpace,
+		filterPop:      q.PopValuesFromFrontWithFilterFunction,
+	}
+}
+
+func newChanBenchmarkQueue(capacity int) *benchmarkQueue {
+	ch := make(chan any, capacity)
+	pushValue := func(value any) error {
+		select {
+		case ch <- value:
+			return nil
+		default:
+			return queue.ErrFull
+		}
+	}
+	popValue := func() (any, bool) {
+		select {
+		case value := <-ch:
+			return value, true
+		default:
+			return nil, false
+		}
+	}
+
+	return &benchmarkQueue{
+		pushValue: pushValue,
+		popValue:  popValue,
+		pushValues: func(values ...any) error {
+			if len(values) > cap(ch)-len(ch) {
+				return queue.ErrInsufficientCapacity
+			}
+			for _, value := range values {
+				ch <- value
+			}
+			return nil
+		},
+		popValues: func(count int) (retValues []any) {
+			for i := 0; i < count; i++ {
+				value, ok := popValue()
+				if !ok {
+					return
+				}
+				retValues = append(retValues, value)
+			}
+			return
+		},
+		popToListSpace: func(ptrListSpace *[]any) (retCount int, retErr error) {
+			listSpace := *ptrListSpace
+			for ; retCount < len(listSpace); retCount++ {
+				value, ok := popValue()
+				if !ok {
+					return
+				}
+				listSpace[retCount] = value
+			}
+			return
+		},
+		filterPop: func(f func(value any) bool) error {
+			for {
+				value, ok := popValue()
+				if !ok || !f(value) {
+					return nil
+				}
+			}
+		},
+	}
+}
+
+type benchmarkBackend struct {
+	name     string
+	newQueue func(b *testing.B, capacity int) *benchmarkQueue
+	// maxConcurrency is the number of producers and consumers the backend
+	// supports at once, zero means it is not safe for concurrent use and a
+	// negative value means it has no limit.
+	maxConcurrency int
+}
+
+func newBenchmarkBackends() []benchmarkBackend {
+	lessFunc := func(a, b any) bool {
+		return a.(int) < b.(int)
+	}
+
+	return []benchmarkBackend{
+		{"Chan", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newChanBenchmarkQueue(capacity)
+		}, -1},
+		{"RingQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newRingQueueBenchmarkQueue(queue.NewRingQueue(capacity + 1))
+		}, 0},
+		{"AutoResizingRingQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newRingQueueBenchmarkQueue(queue.NewAutoResizingRingQueue(16, capacity+1))
+		}, 0},
+		{"PriorityQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			priorityQueue, err := queue.NewPriorityQueue(capacity, lessFunc)
+			if err != nil {
+				b.Fatalf("Failed to create a priority queue, %v", err)
+			}
+			return newRingQueueBenchmarkQueue(priorityQueue)
+		}, 0},
+		{"SPSCRingQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newRingQueueBenchmarkQueue(queue.NewSPSCRingQueue(capacity))
+		}, 1},
+		{"MPMCRingQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newRingQueueBenchmarkQueue(queue.NewMPMCRingQueue(capacity))
+		}, -1},
+		{"LinkListDeque", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newDequeBenchmarkQueue(queue.NewLinkListDeque(capacity))
+		}, 0},
+		{"RingDeque", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newDequeBenchmarkQueue(queue.NewRingDeque(capacity))
+		}, 0},
+		{"LockFreeQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			return newLockFreeBenchmarkQueue(queue.NewLockFreeQueue())
+		}, -1},
+		{"SafetyRingQueue", func(b *testing.B, capacity int) *benchmarkQueue {
+			safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+				return queue.NewRingQueue(capacity + 1)
+			})
+			if newQueueErr != nil {
+				b.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+			}
+			return newRingQueueBenchmarkQueue(safetyQueue)
+		}, -1},
+		{"SafetyLinkListDeque", func(b *testing.B, capacity int) *benchmarkQueue {
+			safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+				return queue.NewLinkListDeque(capacity)
+			})
+			if newDequeErr != nil {
+				b.Fatalf("Failed to create a safety deque, %v", newDequeErr)
+			}
+			return newDequeBenchmarkQueue(safetyDeque)
+		}, -1},
+		{"SafetyRingDeque", func(b *testing.B, capacity int) *benchmarkQueue {
+			safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+				return queue.NewRingDeque(capacity)
+			})
+			if newDequeErr != nil {
+				b.Fatalf("Failed to create a safety deque, %v", newDequeErr)
+			}
+			return newDequeBenchmarkQueue(safetyDeque)
+		}, -1},
+	}
+}
+
+// runBenchmarkBackends runs f for every backend and capacity, each queue is
+// filled to half of its capacity first so pops never find it empty.
+func runBenchmarkBackends(b *testing.B, f func(b *testing.B, q *benchmarkQueue, capacity int)) {
+	for _, backend := range newBenchmarkBackends() {
+		for _, capacity := range benchmarkCapacitySizes {
+			b.Run(fmt.Sprintf("%s/Capacity%d", backend.name, capacity), func(b *testing.B) {
+				q := backend.newQueue(b, capacity)
+				for i := 0; i < capacity/2; i++ {
+					if err := q.pushValue(i); err != nil {
+						b.Fatalf("Failed to fill the queue, %v", err)
+					}
+				}
+				b.ReportAllocs()
+				b.ResetTimer()
+				f(b, q, capacity)
+			})
+		}
+	}
+}
+
+func benchmarkBatchSizeOf(capacity int) int {
+	if capacity/2 < benchmarkBatchSize {
+		return capacity / 2
+	}
+	return benchmarkBatchSize
+}
+
+func BenchmarkQueuePushPop(b *testing.B) {
+	runBenchmarkBackends(b, func(b *testing.B, q *benchmarkQueue, capacity int) {
+		for i := 0; i < b.N; i++ {
+			if err := q.pushValue(i); err != nil {
+				b.Fatalf("Failed to push the value, %v", err)
+			}
+			q.popValue()
+		}
+	})
+}
+
+func BenchmarkQueueBatchPushPop(b *testing.B) {
+	runBenchmarkBackends(b, func(b *testing.B, q *benchmarkQueue, capacity int) {
+		values := make([]any, benchmarkBatchSizeOf(capacity))
+		for i := range values {
+			values[i] = i
+		}
+		for i := 0; i < b.N; i++ {
+			if err := q.pushValues(values...); err != nil {
+				b.Fatalf("Failed to push values, %v", err)
+			}
+			q.popValues(len(values))
+		}
+	})
+}
+
+func BenchmarkQueuePopToListSpace(b *testing.B) {
+	runBenchmarkBackends(b, func(b *testing.B, q *benchmarkQueue, capacity int) {
+		values := make([]any, benchmarkBatchSizeOf(capacity))
+		for i := range values {
+			values[i] = i
+		}
+		listSpace := make([]any, len(values))
+		for i := 0; i < b.N; i++ {
+			if err := q.pushValues(values...); err != nil {
+				b.Fatalf("Failed to push values, %v", err)
+			}
+			if _, err := q.popToListSpace(&listSpace); err != nil {
+				b.Fatalf("Failed to pop values to the list space, %v", err)
+			}
+		}
+	})
+}
+
+func BenchmarkQueueFilterPop(b *testing.B) {
+	runBenchmarkBackends(b, func(b *testing.B, q *benchmarkQueue, capacity int) {
+		values := make([]any, benchmarkBatchSizeOf(capacity))
+		for i := range values {
+			values[i] = i
+		}
+		for i := 0; i < b.N; i++ {
+			if err := q.pushValues(values...); err != nil {
+				b.Fatalf("Failed to push values, %v", err)
+			}
+			popCount := 0
+			if err := q.filterPop(func(value any) bool {
+				popCount += 1
+				return popCount < len(values)
+			}); err != nil {
+				b.Fatalf("Failed to pop values with the filter function, %v", err)
+			}
+		}
+	})
+}
+
+// BenchmarkQueueParallel hands b.N values from the producers to the consumers
+// through every backend that is safe for that many goroutines.
+func BenchmarkQueueParallel(b *testing.B) {
+	capacity := benchmarkRingQueueCapacitySize
+	for _, backend := range newBenchmarkBackends() {
+		for _, ratio := range benchmarkProducerConsumerRatios {
+			producers, consumers := ratio[0], ratio[1]
+			if backend.maxConcurrency == 0 ||
+				(backend.maxConcurrency > 0 && (producers > backend.maxConcurrency || consumers > backend.maxConcurrency)) {
+				continue
+			}
+
+			b.Run(fmt.Sprintf("%s/P%dC%d", backend.name, producers, consumers), func(b *testing.B) {
+				q := backend.newQueue(b, capacity)
+				b.ReportAllocs()
+				b.ResetTimer()
+				benchmarkParallelHandoff(b, q, producers, consumers)
+			})
+		}
+	}
+}
+
+func benchmarkParallelHandoff(b *testing.B, q *benchmarkQueue, producers, consumers int) {
+	var pushedCount, poppedCount atomic.Int64
+	total := int64(b.N)
+	var wg sync.WaitGroup
+
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pushedCount.Add(1) <= total {
+				for q.pushValue(nil) != nil {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for poppedCount.Load() < total {
+				if _, ok := q.popValue(); ok {
+					poppedCount.Add(1)
+					continue
+				}
+				runtime.Gosched()
+			}
+		}()
+	}
+
+	wg.Wait()
+}
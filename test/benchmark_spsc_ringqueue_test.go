@@ -0,0 +1,73 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"testing"
+)
+
+const (
+	benchmarkRingQueueCapacitySize = 1024
+)
+
+func benchmarkRingQueueHandoff(b *testing.B, ringQueue queue.IRingQueue) {
+	b.ReportAllocs()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < b.N; {
+			if _, ok := ringQueue.PopValue(); ok {
+				i += 1
+				continue
+			}
+			runtime.Gosched()
+		}
+	}()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; {
+		if ringQueue.PushValue(nil) == nil {
+			i += 1
+			continue
+		}
+		runtime.Gosched()
+	}
+	<-done
+}
+
+func BenchmarkSPSCRingQueueHandoff(b *testing.B) {
+	benchmarkRingQueueHandoff(b, queue.NewSPSCRingQueue(benchmarkRingQueueCapacitySize))
+}
+
+func BenchmarkSafetyRingQueueHandoff(b *testing.B) {
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(benchmarkRingQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		b.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+	}
+	benchmarkRingQueueHandoff(b, safetyQueue)
+}
+
+func BenchmarkSPSCRingQueuePushPop(b *testing.B) {
+	b.ReportAllocs()
+	ringQueue := queue.NewGenericSPSCRingQueue[int](benchmarkRingQueueCapacitySize)
+	for i := 0; i < b.N; i++ {
+		_ = ringQueue.PushValue(i)
+		ringQueue.PopValue()
+	}
+}
+
+func BenchmarkSafetyRingQueuePushPop(b *testing.B) {
+	b.ReportAllocs()
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(benchmarkRingQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		b.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+	}
+	for i := 0; i < b.N; i++ {
+		_ = safetyQueue.PushValue(nil)
+		safetyQueue.PopValue()
+	}
+}
@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSafetyDequeWait_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewRingDeque(4)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	elemValuesLen := 2000
+	thiefNum := 4
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var poppedCount int64
+	var poppedSum int64
+	var wg sync.WaitGroup
+	for i := 0; i < thiefNum; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt64(&poppedCount) < int64(elemValuesLen) {
+				popCtx, popCancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				value, err := safetyQueue.PopValueFromFrontWait(popCtx)
+				popCancel()
+				if err != nil {
+					continue
+				}
+				atomic.AddInt64(&poppedSum, int64(value.(int)))
+				atomic.AddInt64(&poppedCount, 1)
+			}
+		}()
+	}
+
+	var expectedSum int64
+	for i := 0; i < elemValuesLen; i++ {
+		var err error
+		if i%2 == 0 {
+			err = safetyQueue.PushValueToBackWait(ctx, i)
+		} else {
+			err = safetyQueue.PushValueToFrontWait(ctx, i)
+		}
+		if err != nil {
+			t.Errorf("Failed to push the value to queue, %v", err)
+			break
+		}
+		expectedSum += int64(i)
+	}
+	wg.Wait()
+
+	if poppedSum != expectedSum {
+		t.Error("The pop-up values do not match the result")
+		return
+	}
+}
+
+func TestSafetyDequeWait_2(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewLinkListDeque(-1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// An unbounded deque never blocks the producer
+	for i := 0; i < dequeElemValuesLen; i++ {
+		if err := safetyQueue.PushValueToBackWait(ctx, i); err != nil {
+			t.Errorf("Failed to push the value to queue back, %v", err)
+			return
+		}
+	}
+
+	for i := dequeElemValuesLen - 1; i >= 0; i-- {
+		value, err := safetyQueue.PopValueFromBackWait(ctx)
+		if err != nil {
+			t.Errorf("Failed to pop the value from queue back, %v", err)
+			return
+		}
+		if value != i {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if _, err := safetyQueue.PopValueFromBackWait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Waiting on an empty queue did not time out, %v", err)
+		return
+	}
+}
+
+func TestSafetyDequeWaitWithException_3(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewLinkListDeque(1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	if err := safetyQueue.PushValueToFront(0); err != nil {
+		t.Errorf("Failed to push the value to queue front, %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if err := safetyQueue.PushValueToFrontWait(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Waiting on a full queue was not cancelled, %v", err)
+		return
+	}
+
+	var poppedCount int
+	if err := safetyQueue.PopValuesFromBackWithFilterFunction(func(value interface{}) bool {
+		poppedCount += 1
+		return true
+	}); err != nil {
+		t.Errorf("Failed to pop values to function, %v", err)
+		return
+	}
+	if poppedCount != 1 {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+}
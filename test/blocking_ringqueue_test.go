@@ -0,0 +1,90 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSafetyRingQueueWait_1(t *testing.T) {
+	ringQueueCapacitySize := 4
+	ringQueueElemValuesLen := 1000
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(ringQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < ringQueueElemValuesLen; i++ {
+			if err := safetyQueue.PushValueWait(ctx, i); err != nil {
+				t.Errorf("Failed to push the value to ring queue, %v", err)
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		value, err := safetyQueue.PopValueWait(ctx)
+		if err != nil {
+			t.Errorf("Failed to pop the value from ring queue, %v", err)
+			break
+		}
+		if value != i {
+			t.Error("The pop-up value does not match the result")
+			break
+		}
+	}
+	wg.Wait()
+
+	if safetyQueue.GetLength() > 0 {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestSafetyRingQueueWaitWithException_2(t *testing.T) {
+	ringQueueCapacitySize := 2
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(ringQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	if _, err := safetyQueue.PopValueWaitTimeout(10 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Waiting on an empty queue did not time out, %v", err)
+		return
+	}
+
+	if err := safetyQueue.PushValueWaitTimeout(0, 10*time.Millisecond); err != nil {
+		t.Errorf("Failed to push the value to ring queue, %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if err := safetyQueue.PushValueWait(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Waiting on a full queue was not cancelled, %v", err)
+		return
+	}
+
+	if safetyQueue.GetLength() != 1 {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+}
@@ -0,0 +1,342 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"testing"
+	"time"
+)
+
+const (
+	chanQueueCapacitySize = 3
+	chanElemValuesLen     = 10
+)
+
+func newBlockingFIFOs(tb testing.TB) map[string]func() queue.BlockingFIFO {
+	return map[string]func() queue.BlockingFIFO{
+		"SafetyRingQueue": func() queue.BlockingFIFO {
+			safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+				return queue.NewRingQueue(chanQueueCapacitySize + 1)
+			})
+			if newQueueErr != nil {
+				tb.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+			}
+			return safetyQueue
+		},
+		"SafetyDeque": func() queue.BlockingFIFO {
+			safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+				return queue.NewLinkListDeque(chanQueueCapacitySize)
+			})
+			if newDequeErr != nil {
+				tb.Fatalf("Failed to create a safety deque, %v", newDequeErr)
+			}
+			return safetyDeque
+		},
+	}
+}
+
+// waitForLength reports whether the length of q reaches length before a
+// deadline.
+func waitForLength(q queue.BlockingFIFO, length int) bool {
+	deadline := time.Now().Add(time.Second * 5)
+	for q.GetLength() != length {
+		if time.Now().After(deadline) {
+			return false
+		}
+		runtime.Gosched()
+	}
+	return true
+}
+
+func TestToChan_1(t *testing.T) {
+	for name, newQueueFunc := range newBlockingFIFOs(t) {
+		q := newQueueFunc()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		ch := queue.ToChan(ctx, q)
+
+		go func() {
+			for i := 0; i < chanElemValuesLen; i++ {
+				if err := q.PushValueWait(ctx, i); err != nil {
+					return
+				}
+			}
+			q.(interface{ Close() error }).Close()
+		}()
+
+		var values []int
+		for value := range ch {
+			values = append(values, value.(int))
+		}
+		cancel()
+
+		if len(values) != chanElemValuesLen {
+			t.Errorf("%s: wrong number of values received from the channel, %v", name, values)
+			return
+		}
+		for idx, v := range values {
+			if v != idx {
+				t.Errorf("%s: the received values are out of order, %v", name, values)
+				return
+			}
+		}
+	}
+}
+
+func TestToChan_2(t *testing.T) {
+	for name, newQueueFunc := range newBlockingFIFOs(t) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := queue.ToChan(ctx, newQueueFunc())
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			if ok {
+				t.Errorf("%s: received a value from an empty queue", name)
+				return
+			}
+		case <-time.After(time.Second * 5):
+			t.Errorf("%s: the channel is not closed after the context is done", name)
+			return
+		}
+	}
+}
+
+func TestToChan_3(t *testing.T) {
+	for name, newQueueFunc := range newBlockingFIFOs(t) {
+		q := newQueueFunc()
+		for i := 0; i < 2; i++ {
+			if err := q.PushValue(i); err != nil {
+				t.Errorf("%s: failed to push the value, %v", name, err)
+				return
+			}
+		}
+
+		// The pump pops the front value and blocks on the send, the value
+		// must be back in the queue once the context is done
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := queue.ToChan(ctx, q)
+		if !waitForLength(q, 1) {
+			t.Errorf("%s: the pump did not pop the front value", name)
+			cancel()
+			return
+		}
+		cancel()
+		if !waitForLength(q, 2) {
+			t.Errorf("%s: the value was not pushed back after the context is done", name)
+			return
+		}
+		if _, ok := <-ch; ok {
+			t.Errorf("%s: received a value after the context is done", name)
+			return
+		}
+
+		for i := 0; i < 2; i++ {
+			if value, ok := q.PopValue(); !ok || value != i {
+				t.Errorf("%s: the pop-up value does not match the result, %v", name, value)
+				return
+			}
+		}
+	}
+}
+
+func TestFromChan_1(t *testing.T) {
+	for name, newQueueFunc := range newBlockingFIFOs(t) {
+		q := newQueueFunc()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+
+		ch := make(chan any)
+		go func() {
+			defer close(ch)
+			for i := 0; i < chanElemValuesLen; i++ {
+				ch <- i
+			}
+		}()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- queue.FromChan(ctx, ch, q, queue.BackpressureBlock)
+		}()
+
+		for i := 0; i < chanElemValuesLen; i++ {
+			value, err := q.PopValueWait(ctx)
+			if err != nil || value != i {
+				t.Errorf("%s: the pop-up value does not match the result, %v, %v", name, value, err)
+				cancel()
+				return
+			}
+		}
+		if err := <-errCh; err != nil {
+			t.Errorf("%s: failed to feed the queue from the channel, %v", name, err)
+			cancel()
+			return
+		}
+		cancel()
+	}
+}
+
+func TestFromChan_2(t *testing.T) {
+	policies := map[string]struct {
+		policy queue.BackpressurePolicy
+		values []any
+	}{
+		"DropNewest": {queue.BackpressureDropNewest, []any{0, 1, 2}},
+		"DropOldest": {queue.BackpressureDropOldest, []any{7, 8, 9}},
+	}
+
+	for name, newQueueFunc := range newBlockingFIFOs(t) {
+		for policyName, p := range policies {
+			q := newQueueFunc()
+			ch := make(chan any, chanElemValuesLen)
+			for i := 0; i < chanElemValuesLen; i++ {
+				ch <- i
+			}
+			close(ch)
+
+			if err := queue.FromChan(context.Background(), ch, q, p.policy); err != nil {
+				t.Errorf("%s %s: failed to feed the queue from the channel, %v", name, policyName, err)
+				return
+			}
+			var values []any
+			for !q.IsEmpty() {
+				value, _ := q.PopValue()
+				values = append(values, value)
+			}
+			if len(values) != len(p.values) {
+				t.Errorf("%s %s: the queue holds %v", name, policyName, values)
+				return
+			}
+			for idx, v := range values {
+				if v != p.values[idx] {
+					t.Errorf("%s %s: the queue holds %v", name, policyName, values)
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestFromChan_3(t *testing.T) {
+	for name, newQueueFunc := range newBlockingFIFOs(t) {
+		q := newQueueFunc()
+		ch := make(chan any, 1)
+		ch <- 0
+
+		q.(interface{ Close() error }).Close()
+		if err := queue.FromChan(context.Background(), ch, q, queue.BackpressureBlock); !errors.Is(err, queue.ErrClosed) {
+			t.Errorf("%s: failed to report a closed queue, %v", name, err)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+		err := queue.FromChan(ctx, make(chan any), newQueueFunc(), queue.BackpressureBlock)
+		cancel()
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%s: failed to stop once the context is done, %v", name, err)
+			return
+		}
+	}
+}
+
+func TestGenericToChan_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewGenericSafetyQueue[int](func() queue.GenericFIFO[int] {
+		return queue.NewGenericRingQueue[int](chanQueueCapacitySize + 1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety queue, %v", newQueueErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for i := 0; i < chanElemValuesLen; i++ {
+			in <- i
+		}
+	}()
+	go func() {
+		_ = queue.GenericFromChan[int](ctx, in, safetyQueue, queue.BackpressureBlock)
+		_ = safetyQueue.Close()
+	}()
+
+	sum := 0
+	for v := range queue.GenericToChan[int](ctx, safetyQueue) {
+		sum += v
+	}
+	if sum != chanElemValuesLen*(chanElemValuesLen-1)/2 {
+		t.Errorf("Failed to pass all values through the queue, %d", sum)
+		return
+	}
+}
+
+func TestSafetyQueuePushValueDropFront_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewGenericSafetyQueue[int](func() queue.GenericFIFO[int] {
+		return queue.NewGenericRingQueue[int](chanQueueCapacitySize + 1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety queue, %v", newQueueErr)
+		return
+	}
+
+	for i := 0; i < chanElemValuesLen; i++ {
+		if err := safetyQueue.PushValueDropFront(i); err != nil {
+			t.Errorf("Failed to push the value, %v", err)
+			return
+		}
+		if safetyQueue.GetLength() > chanQueueCapacitySize {
+			t.Error("The queue holds more values than its capacity")
+			return
+		}
+	}
+	values := safetyQueue.PopValues(chanElemValuesLen)
+	if len(values) != chanQueueCapacitySize || values[0] != chanElemValuesLen-chanQueueCapacitySize {
+		t.Errorf("The oldest values were not dropped, %v", values)
+		return
+	}
+
+	// A queue without room for any value drops the new one
+	zeroCapQueue, newQueueErr := queue.NewGenericSafetyQueue[int](func() queue.GenericFIFO[int] {
+		return queue.NewGenericRingQueue[int](1)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety queue, %v", newQueueErr)
+		return
+	}
+	if err := zeroCapQueue.PushValueDropFront(0); err != nil || !zeroCapQueue.IsEmpty() {
+		t.Errorf("Failed to drop the value, %v", err)
+		return
+	}
+
+	if err := safetyQueue.Close(); err != nil {
+		t.Errorf("Failed to close the safety queue, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValueDropFront(0); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Failed to reject a push on a closed queue, %v", err)
+		return
+	}
+
+	// The front of a priority queue is the smallest value, not the oldest
+	priorityQueue, newQueueErr := queue.NewSafetyPriorityQueue(func() *queue.PriorityQueue {
+		return newIntPriorityQueue(t, 2)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety priority queue, %v", newQueueErr)
+		return
+	}
+	if err := priorityQueue.PushValues(5, 1); err != nil || !priorityQueue.IsFull() {
+		t.Errorf("Failed to fill the priority queue, %v", err)
+		return
+	}
+	if err := priorityQueue.PushValueDropFront(9); err != nil {
+		t.Errorf("Failed to push the value, %v", err)
+		return
+	}
+	if values := priorityQueue.PopValues(2); len(values) != 2 || values[0] != 5 || values[1] != 9 {
+		t.Errorf("The front value was not dropped, %v", values)
+		return
+	}
+}
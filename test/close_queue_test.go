@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+	"time"
+)
+
+func TestSafetyRingQueueClose_1(t *testing.T) {
+	ringQueueCapacitySize := 8
+	ringQueueElemValuesLen := 5
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(ringQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		if err := safetyQueue.PushValue(i); err != nil {
+			t.Errorf("Failed to push the value to ring queue, %v", err)
+			return
+		}
+	}
+
+	if err := safetyQueue.Close(); err != nil {
+		t.Errorf("Failed to close the ring queue, %v", err)
+		return
+	}
+	if err := safetyQueue.Close(); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Closing a closed queue did not return ErrClosed, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValue(0); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Pushing to a closed queue did not return ErrClosed, %v", err)
+		return
+	}
+	if _, err := safetyQueue.PushValuesAndRetLength(0, 1); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Pushing to a closed queue did not return ErrClosed, %v", err)
+		return
+	}
+
+	select {
+	case <-safetyQueue.Done():
+		t.Error("The done channel was closed before the queue was drained")
+		return
+	default:
+	}
+
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		value, err := safetyQueue.PopValueWait(context.Background())
+		if err != nil {
+			t.Errorf("Failed to drain the closed ring queue, %v", err)
+			return
+		}
+		if value != i {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if _, err := safetyQueue.PopValueWait(context.Background()); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Popping from a drained queue did not return ErrClosed, %v", err)
+		return
+	}
+
+	select {
+	case <-safetyQueue.Done():
+	case <-time.After(time.Second):
+		t.Error("The done channel was not closed after the queue was drained")
+		return
+	}
+}
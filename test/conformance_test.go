@@ -0,0 +1,100 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"github.com/akley-MK4/go-data-structure/queue/queuetest"
+	"testing"
+)
+
+const (
+	conformanceCapacitySize = 32
+)
+
+func TestRingQueueConformance_1(t *testing.T) {
+	t.Run("RingQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			return queue.NewRingQueue(conformanceCapacitySize)
+		})
+	})
+	t.Run("AutoResizingRingQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			return queue.NewAutoResizingRingQueue(4, conformanceCapacitySize)
+		})
+	})
+	t.Run("UnboundedRingQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			return queue.NewAutoResizingRingQueue(4, -1)
+		})
+	})
+	t.Run("SPSCRingQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			return queue.NewSPSCRingQueue(conformanceCapacitySize)
+		}, queuetest.WithConcurrency(1, 1))
+	})
+	t.Run("MPMCRingQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			return queue.NewMPMCRingQueue(conformanceCapacitySize)
+		}, queuetest.WithConcurrency(4, 4))
+	})
+	t.Run("PriorityQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			return newIntPriorityQueue(t, conformanceCapacitySize)
+		})
+	})
+	t.Run("SafetyRingQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+				return queue.NewRingQueue(conformanceCapacitySize)
+			})
+			if newQueueErr != nil {
+				t.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+			}
+			return safetyQueue
+		}, queuetest.WithConcurrency(4, 4))
+	})
+	t.Run("SafetyPriorityQueue", func(t *testing.T) {
+		queuetest.RunRingQueueConformance(t, func(t *testing.T) queue.IRingQueue {
+			safetyQueue, newQueueErr := queue.NewSafetyPriorityQueue(func() *queue.PriorityQueue {
+				return newIntPriorityQueue(t, conformanceCapacitySize)
+			})
+			if newQueueErr != nil {
+				t.Fatalf("Failed to create a safety priority queue, %v", newQueueErr)
+			}
+			return safetyQueue
+		}, queuetest.WithConcurrency(4, 4))
+	})
+}
+
+func TestDequeConformance_1(t *testing.T) {
+	t.Run("LinkListDeque", func(t *testing.T) {
+		queuetest.RunDequeConformance(t, func(t *testing.T) queue.IDeque {
+			return queue.NewLinkListDeque(conformanceCapacitySize)
+		})
+	})
+	t.Run("UnboundedLinkListDeque", func(t *testing.T) {
+		queuetest.RunDequeConformance(t, func(t *testing.T) queue.IDeque {
+			return queue.NewLinkListDeque(-1)
+		})
+	})
+	t.Run("RingDeque", func(t *testing.T) {
+		queuetest.RunDequeConformance(t, func(t *testing.T) queue.IDeque {
+			return queue.NewRingDeque(conformanceCapacitySize)
+		})
+	})
+	t.Run("ShrinkableRingDeque", func(t *testing.T) {
+		queuetest.RunDequeConformance(t, func(t *testing.T) queue.IDeque {
+			return queue.NewShrinkableRingDeque(-1)
+		})
+	})
+	t.Run("SafetyDeque", func(t *testing.T) {
+		queuetest.RunDequeConformance(t, func(t *testing.T) queue.IDeque {
+			safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+				return queue.NewRingDeque(conformanceCapacitySize)
+			})
+			if newDequeErr != nil {
+				t.Fatalf("Failed to create a safety deque, %v", newDequeErr)
+			}
+			return safetyDeque
+		}, queuetest.WithConcurrency(4, 4))
+	})
+}
@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeClockTimer struct {
+	clock    *fakeClock
+	deadline time.Time
+	ch       chan time.Time
+}
+
+func (t *fakeClockTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeClockTimer) Stop() bool {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+
+	_, exists := t.clock.timers[t]
+	delete(t.clock.timers, t)
+	return exists
+}
+
+type fakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers map[*fakeClockTimer]struct{}
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{
+		now:    time.Unix(0, 0),
+		timers: make(map[*fakeClockTimer]struct{}),
+	}
+}
+
+func (t *fakeClock) Now() time.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.now
+}
+
+func (t *fakeClock) NewTimer(d time.Duration) queue.ClockTimer {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	timer := &fakeClockTimer{clock: t, deadline: t.now.Add(d), ch: make(chan time.Time, 1)}
+	t.timers[timer] = struct{}{}
+	return timer
+}
+
+func (t *fakeClock) Advance(d time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.now = t.now.Add(d)
+	for timer := range t.timers {
+		if !timer.deadline.After(t.now) {
+			timer.ch <- t.now
+			delete(t.timers, timer)
+		}
+	}
+}
+
+func (t *fakeClock) WaitTimer(deadline time.Time) {
+	for {
+		t.mutex.Lock()
+		var exists bool
+		for timer := range t.timers {
+			if timer.deadline.Equal(deadline) {
+				exists = true
+			}
+		}
+		t.mutex.Unlock()
+		if exists {
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
+func TestDelayQueuePopValues_1(t *testing.T) {
+	clock := newFakeClock()
+	delayQueue := queue.NewGenericDelayQueue[int](-1, clock)
+
+	for i := 5; i > 0; i-- {
+		if err := delayQueue.PushValueWithDelay(i, time.Duration(i)*time.Second); err != nil {
+			t.Errorf("Failed to push the value to delay queue, %v", err)
+			return
+		}
+	}
+	if err := delayQueue.PushValueAt(6, clock.Now().Add(5*time.Second)); err != nil {
+		t.Errorf("Failed to push the value to delay queue, %v", err)
+		return
+	}
+
+	if _, ok := delayQueue.PopValue(); ok {
+		t.Error("A value was popped before its deadline")
+		return
+	}
+	if deadline, ok := delayQueue.PeekDeadline(); !ok || !deadline.Equal(clock.Now().Add(time.Second)) {
+		t.Error("The peeked deadline does not match the result")
+		return
+	}
+
+	clock.Advance(2 * time.Second)
+	poppedValues := delayQueue.PopValues(10)
+	if len(poppedValues) != 2 || poppedValues[0] != 1 || poppedValues[1] != 2 {
+		t.Errorf("The pop-up values do not match the result, %v", poppedValues)
+		return
+	}
+
+	clock.Advance(3 * time.Second)
+	poppedValues = delayQueue.PopValues(10)
+	expectedValues := []int{3, 4, 5, 6}
+	if len(poppedValues) != len(expectedValues) {
+		t.Errorf("The pop-up values do not match the result, %v", poppedValues)
+		return
+	}
+	for idx, v := range poppedValues {
+		if v != expectedValues[idx] {
+			t.Errorf("The pop-up values do not match the result, %v", poppedValues)
+			return
+		}
+	}
+}
+
+func TestDelayQueuePopValueWait_2(t *testing.T) {
+	clock := newFakeClock()
+	delayQueue := queue.NewDelayQueue(2, clock)
+
+	if err := delayQueue.PushValueWithDelay("late", time.Minute); err != nil {
+		t.Errorf("Failed to push the value to delay queue, %v", err)
+		return
+	}
+
+	resultCh := make(chan any, 1)
+	go func() {
+		value, err := delayQueue.PopValueWait(context.Background())
+		if err != nil {
+			t.Errorf("Failed to wait for the value, %v", err)
+		}
+		resultCh <- value
+	}()
+
+	// An earlier value pushed while waiting must wake the waiter up
+	clock.WaitTimer(clock.Now().Add(time.Minute))
+	if err := delayQueue.PushValueWithDelay("early", time.Second); err != nil {
+		t.Errorf("Failed to push the value to delay queue, %v", err)
+		return
+	}
+	if err := delayQueue.PushValueWithDelay("full", time.Second); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+
+	clock.WaitTimer(clock.Now().Add(time.Second))
+	clock.Advance(time.Second)
+	if value := <-resultCh; value != "early" {
+		t.Errorf("The pop-up value does not match the result, %v", value)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err := delayQueue.PopValueWait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Waiting for the late value was not cancelled, %v", err)
+		return
+	}
+	if delayQueue.GetLength() != 1 {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+}
@@ -0,0 +1,112 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func TestRingQueueErrors_1(t *testing.T) {
+	ringQueueCapacitySize := 5
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(ringQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	if err := safetyQueue.PushValues(1, 2); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	err := safetyQueue.PushValues(3, 4, 5)
+	if !errors.Is(err, queue.ErrInsufficientCapacity) {
+		t.Errorf("The error is not ErrInsufficientCapacity, %v", err)
+		return
+	}
+	var capErr *queue.CapacityError
+	if !errors.As(err, &capErr) || capErr.Requested != 3 || capErr.Available != 2 {
+		t.Errorf("The capacity error carries wrong counts, %v", err)
+		return
+	}
+	if safetyQueue.GetLength() != 2 {
+		t.Error("A rejected batch push must not modify the queue")
+		return
+	}
+
+	if err := safetyQueue.PushValues(3, 4); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValue(5); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+	if err := safetyQueue.PopValuesWithFilterFunction(nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("The error is not ErrNilFunc, %v", err)
+		return
+	}
+	if _, err := safetyQueue.PopValuesToListSpace(nil); !errors.Is(err, queue.ErrNilListSpace) {
+		t.Errorf("The error is not ErrNilListSpace, %v", err)
+		return
+	}
+	var listSpace []any
+	if _, err := safetyQueue.PopValuesToListSpace(&listSpace); !errors.Is(err, queue.ErrZeroCapListSpace) {
+		t.Errorf("The error is not ErrZeroCapListSpace, %v", err)
+		return
+	}
+
+	if _, err := queue.NewSafetyRingDeque(func() queue.IRingQueue { return nil }); !errors.Is(err, queue.ErrNilInstance) {
+		t.Errorf("The error is not ErrNilInstance, %v", err)
+		return
+	}
+}
+
+func TestDequeErrors_2(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewLinkListDeque(2)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+		return
+	}
+
+	err := safetyQueue.PushValuesToFront(1, 2, 3)
+	var capErr *queue.CapacityError
+	if !errors.Is(err, queue.ErrInsufficientCapacity) || !errors.As(err, &capErr) || capErr.Requested != 3 || capErr.Available != 2 {
+		t.Errorf("The error is not a valid capacity error, %v", err)
+		return
+	}
+
+	if err := safetyQueue.PushValuesToBack(1, 2); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValueToBack(3); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+	if err := safetyQueue.PopValuesFromBackWithFilterFunction(nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("The error is not ErrNilFunc, %v", err)
+		return
+	}
+
+	queueInst := safetyQueue.GetQueueInstance().(*queue.LinkListDeque)
+	safetyQueue.ExecuteWriteMethod(func() {
+		if _, err := queueInst.PopValuesFromFrontToListSpace(nil); !errors.Is(err, queue.ErrNilListSpace) {
+			t.Errorf("The error is not ErrNilListSpace, %v", err)
+		}
+		listSpace := make([]any, 0)
+		if _, err := queueInst.PopValuesFromBackToListSpace(&listSpace); !errors.Is(err, queue.ErrZeroCapListSpace) {
+			t.Errorf("The error is not ErrZeroCapListSpace, %v", err)
+		}
+	})
+
+	if _, err := queue.NewSafetyDeque(func() queue.IDeque { return nil }); !errors.Is(err, queue.ErrNilInstance) {
+		t.Errorf("The error is not ErrNilInstance, %v", err)
+		return
+	}
+}
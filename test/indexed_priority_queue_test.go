@@ -0,0 +1,134 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestIndexedPriorityQueueUpdate_1(t *testing.T) {
+	elemValuesLen := 300
+
+	priorityQueue, newQueueErr := queue.NewGenericIndexedPriorityQueue[string, int](-1, func(a, b int) bool {
+		return a < b
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create an indexed priority queue, %v", newQueueErr)
+		return
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	priorities := make(map[*queue.PriorityQueueHandle[string, int]]int)
+	for i := 0; i < elemValuesLen; i++ {
+		priority := rnd.Intn(1000)
+		handle, err := priorityQueue.PushValue("elem", priority)
+		if err != nil {
+			t.Errorf("Failed to push the value to priority queue, %v", err)
+			return
+		}
+		priorities[handle] = priority
+	}
+
+	for handle := range priorities {
+		switch rnd.Intn(3) {
+		case 0:
+			priority := rnd.Intn(1000)
+			if err := priorityQueue.Update(handle, priority); err != nil {
+				t.Errorf("Failed to update the priority, %v", err)
+				return
+			}
+			priorities[handle] = priority
+		case 1:
+			if _, ok := priorityQueue.Remove(handle); !ok {
+				t.Error("Failed to remove the value by handle")
+				return
+			}
+			if priorityQueue.Contains(handle) {
+				t.Error("The removed handle is still contained in the queue")
+				return
+			}
+			delete(priorities, handle)
+		}
+	}
+
+	var expectedPriorities []int
+	for _, priority := range priorities {
+		expectedPriorities = append(expectedPriorities, priority)
+	}
+	sort.Ints(expectedPriorities)
+
+	if priorityQueue.GetLength() != len(expectedPriorities) {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+	for _, expectedPriority := range expectedPriorities {
+		handle, ok := priorityQueue.PeekHandle()
+		if !ok || handle.GetPriority() != expectedPriority {
+			t.Error("The peeked priority does not match the result")
+			return
+		}
+		if _, ok := priorityQueue.PopValue(); !ok {
+			t.Error("Failed to pop the value from priority queue")
+			return
+		}
+		if priorityQueue.Contains(handle) {
+			t.Error("The popped handle is still contained in the queue")
+			return
+		}
+	}
+}
+
+func TestIndexedPriorityQueueWithException_2(t *testing.T) {
+	lessFunc := func(a, b any) bool {
+		return a.(int) < b.(int)
+	}
+	priorityQueue, newQueueErr := queue.NewIndexedPriorityQueue(1, lessFunc)
+	if newQueueErr != nil {
+		t.Errorf("Failed to create an indexed priority queue, %v", newQueueErr)
+		return
+	}
+	otherQueue, newQueueErr := queue.NewIndexedPriorityQueue(1, lessFunc)
+	if newQueueErr != nil {
+		t.Errorf("Failed to create an indexed priority queue, %v", newQueueErr)
+		return
+	}
+
+	handle, err := priorityQueue.PushValue("a", 1)
+	if err != nil {
+		t.Errorf("Failed to push the value to priority queue, %v", err)
+		return
+	}
+	if _, err := priorityQueue.PushValue("b", 0); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+
+	if otherQueue.Contains(handle) {
+		t.Error("The handle of another queue is contained in the queue")
+		return
+	}
+	if err := otherQueue.Update(handle, 0); !errors.Is(err, queue.ErrInvalidHandle) {
+		t.Errorf("The error is not ErrInvalidHandle, %v", err)
+		return
+	}
+
+	if value, ok := priorityQueue.Remove(handle); !ok || value != "a" {
+		t.Error("Failed to remove the value by handle")
+		return
+	}
+	if _, ok := priorityQueue.Remove(handle); ok {
+		t.Error("A handle was removed twice")
+		return
+	}
+	if err := priorityQueue.Update(handle, 0); !errors.Is(err, queue.ErrInvalidHandle) {
+		t.Errorf("The error is not ErrInvalidHandle, %v", err)
+		return
+	}
+
+	if _, err := queue.NewIndexedPriorityQueue(1, nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("The error is not ErrNilFunc, %v", err)
+		return
+	}
+}
@@ -0,0 +1,377 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+	"time"
+)
+
+func newRingQueueDequeAdapter(tb testing.TB) *queue.RingQueueDequeAdapter {
+	adapter, newAdapterErr := queue.NewRingQueueDequeAdapter(queue.NewRingQueue(dequeCapacitySize))
+	if newAdapterErr != nil {
+		tb.Fatalf("Failed to create a ring queue deque adapter, %v", newAdapterErr)
+	}
+	return adapter
+}
+
+func newFIFOs(tb testing.TB) map[string]queue.FIFO {
+	safetyRingQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(dequeCapacitySize)
+	})
+	if newQueueErr != nil {
+		tb.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+	}
+	safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewLinkListDeque(dequeCapacitySize)
+	})
+	if newDequeErr != nil {
+		tb.Fatalf("Failed to create a safety deque, %v", newDequeErr)
+	}
+	safetyQueue, newQueueErr := queue.NewSafetyQueue(func() queue.FIFO {
+		return queue.NewLockFreeQueue()
+	})
+	if newQueueErr != nil {
+		tb.Fatalf("Failed to create a safety queue, %v", newQueueErr)
+	}
+
+	return map[string]queue.FIFO{
+		"RingQueue":             queue.NewRingQueue(dequeCapacitySize),
+		"SPSCRingQueue":         queue.NewSPSCRingQueue(dequeCapacitySize),
+		"MPMCRingQueue":         queue.NewMPMCRingQueue(dequeCapacitySize),
+		"LinkListDeque":         queue.NewLinkListDeque(dequeCapacitySize),
+		"RingDeque":             queue.NewRingDeque(dequeCapacitySize),
+		"LockFreeQueue":         queue.NewLockFreeQueue(),
+		"RingQueueDequeAdapter": newRingQueueDequeAdapter(tb),
+		"SafetyRingQueue":       safetyRingQueue,
+		"SafetyDeque":           safetyDeque,
+		"SafetyQueue":           safetyQueue,
+	}
+}
+
+func TestFIFOInterface_1(t *testing.T) {
+	for name, fifo := range newFIFOs(t) {
+		for i := 0; i < 5; i++ {
+			if err := fifo.PushValue(i); err != nil {
+				t.Errorf("%s: failed to push the value, %v", name, err)
+				return
+			}
+		}
+		if fifo.GetLength() != 5 || fifo.IsEmpty() {
+			t.Errorf("%s: wrong number of elements", name)
+			return
+		}
+		for i := 0; i < 5; i++ {
+			value, ok := fifo.PopValue()
+			if !ok || value != i {
+				t.Errorf("%s: the pop-up value does not match the result", name)
+				return
+			}
+		}
+		if _, ok := fifo.PopValue(); ok || !fifo.IsEmpty() {
+			t.Errorf("%s: the queue is expected to be empty", name)
+			return
+		}
+	}
+}
+
+func TestBoundedInterface_1(t *testing.T) {
+	for name, fifo := range newFIFOs(t) {
+		bounded, ok := fifo.(queue.Bounded)
+		if !ok {
+			continue
+		}
+
+		availableCapSize := bounded.GetAvailableCapacitySize()
+		if availableCapSize < 0 {
+			if bounded.IsFull() {
+				t.Errorf("%s: an unbounded queue is reported full", name)
+				return
+			}
+			continue
+		}
+		for i := 0; i < availableCapSize; i++ {
+			if err := fifo.PushValue(i); err != nil {
+				t.Errorf("%s: failed to push the value, %v", name, err)
+				return
+			}
+		}
+		if !bounded.IsFull() || bounded.GetAvailableCapacitySize() != 0 {
+			t.Errorf("%s: the queue is expected to be full", name)
+			return
+		}
+		if err := fifo.PushValue(-1); !errors.Is(err, queue.ErrFull) {
+			t.Errorf("%s: failed to reject a push on a full queue, %v", name, err)
+			return
+		}
+	}
+}
+
+func TestLIFOInterface_1(t *testing.T) {
+	lifos := map[string]queue.LIFO{
+		"LinkListDeque":         queue.NewLinkListDeque(dequeCapacitySize),
+		"RingDeque":             queue.NewRingDeque(dequeCapacitySize),
+		"WorkStealingDeque":     queue.NewWorkStealingDeque(),
+		"RingQueueDequeAdapter": newRingQueueDequeAdapter(t),
+	}
+
+	for name, lifo := range lifos {
+		for i := 0; i < 5; i++ {
+			if err := lifo.PushValueToBack(i); err != nil {
+				t.Errorf("%s: failed to push the value, %v", name, err)
+				return
+			}
+		}
+		for i := 4; i >= 0; i-- {
+			value, ok := lifo.PopValueFromBack()
+			if !ok || value != i {
+				t.Errorf("%s: the pop-up value does not match the result", name)
+				return
+			}
+		}
+		if !lifo.IsEmpty() {
+			t.Errorf("%s: the queue is expected to be empty", name)
+			return
+		}
+	}
+}
+
+func TestDequeInterface_1(t *testing.T) {
+	safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewRingDeque(dequeCapacitySize)
+	})
+	if newDequeErr != nil {
+		t.Errorf("Failed to create a safety deque, %v", newDequeErr)
+		return
+	}
+
+	deques := map[string]queue.Deque{
+		"LinkListDeque":         queue.NewLinkListDeque(dequeCapacitySize),
+		"RingDeque":             queue.NewRingDeque(dequeCapacitySize),
+		"RingQueueDequeAdapter": newRingQueueDequeAdapter(t),
+		"SafetyDeque":           safetyDeque,
+	}
+
+	for name, deque := range deques {
+		if err := deque.PushValue(1); err != nil {
+			t.Errorf("%s: failed to push the value, %v", name, err)
+			return
+		}
+		if err := deque.PushValueToFront(0); err != nil {
+			t.Errorf("%s: failed to push the value, %v", name, err)
+			return
+		}
+		if err := deque.PushValueToBack(2); err != nil {
+			t.Errorf("%s: failed to push the value, %v", name, err)
+			return
+		}
+
+		if value, ok := deque.PopValueFromBack(); !ok || value != 2 {
+			t.Errorf("%s: the pop-up value does not match the result", name)
+			return
+		}
+		if value, ok := deque.PopValue(); !ok || value != 0 {
+			t.Errorf("%s: the pop-up value does not match the result", name)
+			return
+		}
+		if value, ok := deque.PopValueFromFront(); !ok || value != 1 {
+			t.Errorf("%s: the pop-up value does not match the result", name)
+			return
+		}
+		if _, ok := deque.PopValueFromBack(); ok {
+			t.Errorf("%s: the deque is expected to be empty", name)
+			return
+		}
+	}
+}
+
+func TestGenericSafetyQueue_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewGenericSafetyQueue[int](func() queue.GenericFIFO[int] {
+		return queue.NewGenericLinkListDeque[int](2)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValue(0); err != nil {
+		t.Errorf("Failed to push the value, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValue(1); err != nil {
+		t.Errorf("Failed to push the value, %v", err)
+		return
+	}
+	if !safetyQueue.IsFull() {
+		t.Error("The safety queue is expected to be full")
+		return
+	}
+	if err := safetyQueue.PushValueWaitTimeout(2, time.Millisecond*20); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Failed to time out a push on a full queue, %v", err)
+		return
+	}
+
+	pushErrCh := make(chan error, 1)
+	go func() {
+		pushErrCh <- safetyQueue.PushValueWait(context.Background(), 2)
+	}()
+	if value, ok := safetyQueue.PopValue(); !ok || value != 0 {
+		t.Error("The pop-up value does not match the result")
+		return
+	}
+	if err := <-pushErrCh; err != nil {
+		t.Errorf("Failed to push the value after a pop, %v", err)
+		return
+	}
+
+	if err := safetyQueue.Close(); err != nil {
+		t.Errorf("Failed to close the safety queue, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValue(3); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Failed to reject a push on a closed queue, %v", err)
+		return
+	}
+	values := safetyQueue.PopValues(dequeElemValuesLen)
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("Failed to drain the closed queue, %v", values)
+		return
+	}
+	if _, err := safetyQueue.PopValueWait(context.Background()); !errors.Is(err, queue.ErrClosed) {
+		t.Errorf("Failed to report a drained queue, %v", err)
+		return
+	}
+	select {
+	case <-safetyQueue.Done():
+	default:
+		t.Error("The done channel is expected to be closed")
+		return
+	}
+}
+
+func TestDequeFIFOAdapter_1(t *testing.T) {
+	var fifo queue.GenericFIFO[int] = queue.NewGenericDequeFIFOAdapter[int](queue.NewGenericRingDeque[int](2))
+	if _, ok := fifo.(queue.Bounded); !ok {
+		t.Error("The adapter is expected to be bounded")
+		return
+	}
+	if _, ok := fifo.(queue.GenericPeekable[int]); !ok {
+		t.Error("The adapter is expected to be peekable")
+		return
+	}
+
+	safetyQueue, newQueueErr := queue.NewGenericSafetyQueue[int](func() queue.GenericFIFO[int] {
+		return fifo
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValues(0, 1, 2); err == nil {
+		t.Error("Pushing more values than the capacity succeeded")
+		return
+	}
+	if err := safetyQueue.PushValues(0, 1); err != nil {
+		t.Errorf("Failed to push the values, %v", err)
+		return
+	}
+	if !safetyQueue.IsFull() {
+		t.Error("The safety queue is expected to be full")
+		return
+	}
+	if value, ok := safetyQueue.PeekBack(); !ok || value != 1 {
+		t.Error("The peeked back value does not match the result")
+		return
+	}
+	if value, ok := safetyQueue.PopValue(); !ok || value != 0 {
+		t.Error("The pop-up value does not match the result")
+		return
+	}
+}
+
+func TestRingQueueDequeAdapter_1(t *testing.T) {
+	if _, err := queue.NewRingQueueDequeAdapter(queue.NewMPMCRingQueue(dequeCapacitySize)); !errors.Is(err, queue.ErrUnsupportedOperation) {
+		t.Errorf("Failed to reject a ring queue without index access, %v", err)
+		return
+	}
+	if _, err := queue.NewRingQueueDequeAdapter(nil); !errors.Is(err, queue.ErrNilInstance) {
+		t.Errorf("Failed to reject a nil ring queue, %v", err)
+		return
+	}
+
+	// A safety wrapper is only accepted if the queue it wraps has index access
+	newSafetyRingQueue := func(inst queue.IRingQueue) *queue.SafetyRingQueue {
+		safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+			return inst
+		})
+		if newQueueErr != nil {
+			t.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+		}
+		return safetyQueue
+	}
+	if _, err := queue.NewRingQueueDequeAdapter(newSafetyRingQueue(queue.NewMPMCRingQueue(dequeCapacitySize))); !errors.Is(err, queue.ErrUnsupportedOperation) {
+		t.Errorf("Failed to reject a safety ring queue without index access, %v", err)
+		return
+	}
+	safetyDeque, newAdapterErr := queue.NewRingQueueDequeAdapter(newSafetyRingQueue(queue.NewRingQueue(dequeCapacitySize)))
+	if newAdapterErr != nil {
+		t.Errorf("Failed to create a ring queue deque adapter, %v", newAdapterErr)
+		return
+	}
+	if err := safetyDeque.PushValueToFront(1); err != nil {
+		t.Errorf("Failed to push the value to queue front, %v", err)
+		return
+	}
+	if value, ok := safetyDeque.PopValueFromBack(); !ok || value != 1 {
+		t.Error("The pop-up value does not match the result")
+		return
+	}
+	if _, ok := safetyDeque.PopValueFromBack(); ok {
+		t.Error("The deque is expected to be empty")
+		return
+	}
+
+	// Wrap around the ring from both ends
+	deque := newRingQueueDequeAdapter(t)
+	pairCount := deque.GetAvailableCapacitySize() / 2
+	for i := 0; i < pairCount; i++ {
+		if err := deque.PushValueToFront(-i - 1); err != nil {
+			t.Errorf("Failed to push the value to queue front, %v", err)
+			return
+		}
+		if err := deque.PushValueToBack(i); err != nil {
+			t.Errorf("Failed to push the value to queue back, %v", err)
+			return
+		}
+	}
+	for deque.GetAvailableCapacitySize() > 0 {
+		if err := deque.PushValueToFront(-pairCount - 1); err != nil {
+			t.Errorf("Failed to push the value to queue front, %v", err)
+			return
+		}
+	}
+	if err := deque.PushValueToFront(0); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("Failed to reject a push on a full queue, %v", err)
+		return
+	}
+	for deque.GetLength() > pairCount*2 {
+		if value, ok := deque.PopValueFromFront(); !ok || value != -pairCount-1 {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+	for i := pairCount - 1; i >= 0; i-- {
+		if value, ok := deque.PopValueFromBack(); !ok || value != i {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+		if value, ok := deque.PopValueFromFront(); !ok || value != -i-1 {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+	if _, ok := deque.PopValueFromBack(); ok {
+		t.Error("The deque is expected to be empty")
+		return
+	}
+}
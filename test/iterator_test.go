@@ -0,0 +1,405 @@
+//go:build go1.23
+
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+	"time"
+)
+
+func TestGenericRingQueueIterator_1(t *testing.T) {
+	ringQueue := queue.NewGenericRingQueue[int](8)
+	if err := ringQueue.PushValues(-1, -1, -1, -1, -1); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	ringQueue.PopValues(5)
+	elemValues := []int{0, 1, 2, 3, 4, 5}
+	if err := ringQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	var values []int
+	for v := range ringQueue.All() {
+		values = append(values, v)
+	}
+	if !equalIntValues(values, elemValues) {
+		t.Errorf("Failed to iterate the ring queue in logical order, %v", values)
+		return
+	}
+
+	values = values[:0]
+	for v := range ringQueue.Backward() {
+		if v < 3 {
+			break
+		}
+		values = append(values, v)
+	}
+	if !equalIntValues(values, []int{5, 4, 3}) {
+		t.Errorf("Failed to iterate the ring queue backward, %v", values)
+		return
+	}
+
+	values = values[:0]
+	for v := range ringQueue.Drain() {
+		values = append(values, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || ringQueue.GetLength() != 3 {
+		t.Errorf("Failed to drain the ring queue, %v", values)
+		return
+	}
+}
+
+func TestGenericDequeIterator_1(t *testing.T) {
+	newDequeFuncs := map[string]func() queue.IGenericDeque[int]{
+		"LinkListDeque": func() queue.IGenericDeque[int] {
+			return queue.NewGenericLinkListDeque[int](dequeCapacitySize)
+		},
+		"RingDeque": func() queue.IGenericDeque[int] {
+			return queue.NewGenericRingDeque[int](dequeCapacitySize)
+		},
+	}
+
+	for name, newDequeFunc := range newDequeFuncs {
+		deque := newDequeFunc()
+		if err := deque.PushValuesToBack(1, 2, 3); err != nil {
+			t.Errorf("%s: failed to push values to the deque, %v", name, err)
+			return
+		}
+		if err := deque.PushValueToFront(0); err != nil {
+			t.Errorf("%s: failed to push a value to the deque, %v", name, err)
+			return
+		}
+
+		var all, backward, drained []int
+		switch d := deque.(type) {
+		case *queue.GenericLinkListDeque[int]:
+			for v := range d.All() {
+				all = append(all, v)
+			}
+			for v := range d.Backward() {
+				backward = append(backward, v)
+			}
+			for v := range d.Drain() {
+				drained = append(drained, v)
+			}
+		case *queue.GenericRingDeque[int]:
+			for v := range d.All() {
+				all = append(all, v)
+			}
+			for v := range d.Backward() {
+				backward = append(backward, v)
+			}
+			for v := range d.Drain() {
+				drained = append(drained, v)
+			}
+		}
+
+		if !equalIntValues(all, []int{0, 1, 2, 3}) {
+			t.Errorf("%s: failed to iterate the deque from front, %v", name, all)
+			return
+		}
+		if !equalIntValues(backward, []int{3, 2, 1, 0}) {
+			t.Errorf("%s: failed to iterate the deque from back, %v", name, backward)
+			return
+		}
+		if !equalIntValues(drained, all) || !deque.IsEmpty() {
+			t.Errorf("%s: failed to drain the deque, %v", name, drained)
+			return
+		}
+	}
+}
+
+func TestSafetyRingQueueIterator_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(8)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValues(0, 1, 2); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	// All ranges over a snapshot, so writing to the queue inside the loop
+	// neither deadlocks nor changes the values being iterated.
+	var values []int
+	for v := range safetyQueue.All() {
+		values = append(values, v.(int))
+		if err := safetyQueue.PushValue(v.(int) + 10); err != nil {
+			t.Errorf("Failed to push a value while iterating, %v", err)
+			return
+		}
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || safetyQueue.GetLength() != 6 {
+		t.Errorf("Failed to iterate a snapshot of the ring queue, %v", values)
+		return
+	}
+
+	values = values[:0]
+	for v := range safetyQueue.Backward() {
+		values = append(values, v.(int))
+	}
+	if !equalIntValues(values, []int{12, 11, 10, 2, 1, 0}) {
+		t.Errorf("Failed to iterate the ring queue backward, %v", values)
+		return
+	}
+
+	// Drain does not hold the lock while the loop body runs.
+	values = values[:0]
+	for v := range safetyQueue.Drain() {
+		values = append(values, v.(int))
+		if safetyQueue.GetLength() != 6-len(values) {
+			t.Errorf("Failed to pop a value per iteration")
+			return
+		}
+	}
+	if len(values) != 6 || !safetyQueue.IsEmpty() {
+		t.Errorf("Failed to drain the ring queue, %v", values)
+		return
+	}
+}
+
+func TestSafetyRingQueueIterator_2(t *testing.T) {
+	ringQueueCapacitySize := 8
+
+	newRingQueueFuncs := map[string]func() queue.IRingQueue{
+		"RingQueue": func() queue.IRingQueue {
+			return queue.NewRingQueue(ringQueueCapacitySize)
+		},
+		"AutoResizingRingQueue": func() queue.IRingQueue {
+			return queue.NewAutoResizingRingQueue(2, ringQueueCapacitySize)
+		},
+		"SPSCRingQueue": func() queue.IRingQueue {
+			return queue.NewSPSCRingQueue(ringQueueCapacitySize)
+		},
+		"PriorityQueue": func() queue.IRingQueue {
+			return newIntPriorityQueue(t, ringQueueCapacitySize)
+		},
+		"MPMCRingQueue": func() queue.IRingQueue {
+			return queue.NewMPMCRingQueue(ringQueueCapacitySize)
+		},
+	}
+
+	for name, newRingQueueFunc := range newRingQueueFuncs {
+		safetyQueue, newQueueErr := queue.NewSafetyRingDeque(newRingQueueFunc)
+		if newQueueErr != nil {
+			t.Errorf("%s: failed to create a safety ring queue, %v", name, newQueueErr)
+			return
+		}
+		if err := safetyQueue.PushValues(0, 1, 2); err != nil {
+			t.Errorf("%s: failed to push values to the ring queue, %v", name, err)
+			return
+		}
+
+		var values []int
+		for v := range safetyQueue.All() {
+			values = append(values, v.(int))
+		}
+		if _, peekable := safetyQueue.GetQueueInstance().(queue.Peekable); !peekable {
+			if len(values) != 0 {
+				t.Errorf("%s: iterating a queue that can not peek yielded values, %v", name, values)
+				return
+			}
+		} else if !equalIntValues(values, []int{0, 1, 2}) {
+			t.Errorf("%s: failed to iterate the ring queue, %v", name, values)
+			return
+		}
+
+		values = values[:0]
+		for v := range safetyQueue.Drain() {
+			values = append(values, v.(int))
+		}
+		if !equalIntValues(values, []int{0, 1, 2}) || !safetyQueue.IsEmpty() {
+			t.Errorf("%s: failed to drain the ring queue, %v", name, values)
+			return
+		}
+	}
+}
+
+func TestSafetyDequeIterator_1(t *testing.T) {
+	safetyDeque, newDequeErr := queue.NewSafetyDeque(func() queue.IDeque {
+		return queue.NewLinkListDeque(dequeCapacitySize)
+	})
+	if newDequeErr != nil {
+		t.Errorf("Failed to create a safety deque, %v", newDequeErr)
+		return
+	}
+	if err := safetyDeque.PushValuesToBack(0, 1, 2); err != nil {
+		t.Errorf("Failed to push values to the deque, %v", err)
+		return
+	}
+
+	var values []int
+	for v := range safetyDeque.All() {
+		values = append(values, v.(int))
+		if _, ok := safetyDeque.PopValueFromBack(); !ok {
+			t.Errorf("Failed to pop a value while iterating")
+			return
+		}
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || !safetyDeque.IsEmpty() {
+		t.Errorf("Failed to iterate a snapshot of the deque, %v", values)
+		return
+	}
+
+	if err := safetyDeque.PushValuesToBack(0, 1, 2); err != nil {
+		t.Errorf("Failed to push values to the deque, %v", err)
+		return
+	}
+	values = values[:0]
+	for v := range safetyDeque.Backward() {
+		values = append(values, v.(int))
+	}
+	if !equalIntValues(values, []int{2, 1, 0}) {
+		t.Errorf("Failed to iterate the deque backward, %v", values)
+		return
+	}
+
+	values = values[:0]
+	for v := range safetyDeque.Drain() {
+		values = append(values, v.(int))
+		if len(values) == 3 {
+			break
+		}
+		if err := safetyDeque.PushValueToBack(v); err != nil {
+			t.Errorf("Failed to push a value while draining, %v", err)
+			return
+		}
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || safetyDeque.GetLength() != 2 {
+		t.Errorf("Failed to drain the deque, %v", values)
+		return
+	}
+}
+
+func TestGenericIndexedPriorityQueueIterator_1(t *testing.T) {
+	priorityQueue, newQueueErr := queue.NewGenericIndexedPriorityQueue[int, int](-1, func(a, b int) bool {
+		return a < b
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create an indexed priority queue, %v", newQueueErr)
+		return
+	}
+	for _, v := range []int{3, 0, 4, 1, 2} {
+		if _, err := priorityQueue.PushValue(v, v); err != nil {
+			t.Errorf("Failed to push the value, %v", err)
+			return
+		}
+	}
+
+	var all, backward, drained []int
+	for v := range priorityQueue.All() {
+		all = append(all, v)
+	}
+	for v := range priorityQueue.Backward() {
+		backward = append(backward, v)
+	}
+	for v := range priorityQueue.Drain() {
+		drained = append(drained, v)
+	}
+	if !equalIntValues(all, []int{0, 1, 2, 3, 4}) || !equalIntValues(backward, []int{4, 3, 2, 1, 0}) {
+		t.Errorf("Failed to iterate the indexed priority queue in pop order, %v, %v", all, backward)
+		return
+	}
+	if !equalIntValues(drained, []int{0, 1, 2, 3, 4}) || !priorityQueue.IsEmpty() {
+		t.Errorf("Failed to drain the indexed priority queue, %v", drained)
+		return
+	}
+}
+
+func TestGenericDelayQueueIterator_1(t *testing.T) {
+	clock := newFakeClock()
+	delayQueue := queue.NewGenericDelayQueue[int](-1, clock)
+	for _, v := range []int{2, 0, 1} {
+		if err := delayQueue.PushValueWithDelay(v, time.Duration(v+1)*time.Second); err != nil {
+			t.Errorf("Failed to push the value, %v", err)
+			return
+		}
+	}
+
+	var all, backward, drained []int
+	for v := range delayQueue.All() {
+		all = append(all, v)
+	}
+	for v := range delayQueue.Backward() {
+		backward = append(backward, v)
+	}
+	if !equalIntValues(all, []int{0, 1, 2}) || !equalIntValues(backward, []int{2, 1, 0}) {
+		t.Errorf("Failed to iterate the delay queue in deadline order, %v, %v", all, backward)
+		return
+	}
+
+	// Only the expired values are drained
+	clock.Advance(time.Second * 2)
+	for v := range delayQueue.Drain() {
+		drained = append(drained, v)
+	}
+	if !equalIntValues(drained, []int{0, 1}) || delayQueue.GetLength() != 1 {
+		t.Errorf("Failed to drain the expired values of the delay queue, %v", drained)
+		return
+	}
+}
+
+func TestGenericLockFreeQueueIterator_1(t *testing.T) {
+	lockFreeQueue := queue.NewGenericLockFreeQueue[int]()
+	if err := lockFreeQueue.PushValuesToBack(-1, 0, 1, 2); err != nil {
+		t.Errorf("Failed to push values to the lock-free queue, %v", err)
+		return
+	}
+	lockFreeQueue.PopValueFromFront()
+
+	var values []int
+	for v := range lockFreeQueue.All() {
+		values = append(values, v)
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || lockFreeQueue.GetLength() != 3 {
+		t.Errorf("Failed to iterate the lock-free queue, %v", values)
+		return
+	}
+
+	values = values[:0]
+	for v := range lockFreeQueue.Drain() {
+		values = append(values, v)
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || !lockFreeQueue.IsEmpty() {
+		t.Errorf("Failed to drain the lock-free queue, %v", values)
+		return
+	}
+}
+
+func TestGenericWorkStealingDequeIterator_1(t *testing.T) {
+	deque := queue.NewGenericWorkStealingDeque[int]()
+	if err := deque.PushValuesToBack(0, 1, 2); err != nil {
+		t.Errorf("Failed to push values to the work stealing deque, %v", err)
+		return
+	}
+
+	var values []int
+	for v := range deque.Drain() {
+		values = append(values, v)
+	}
+	if !equalIntValues(values, []int{0, 1, 2}) || !deque.IsEmpty() {
+		t.Errorf("Failed to drain the work stealing deque from the front, %v", values)
+		return
+	}
+}
+
+func equalIntValues(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
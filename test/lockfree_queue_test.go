@@ -0,0 +1,146 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestLockFreeQueuePushValues_1(t *testing.T) {
+	lockFreeQueue := queue.NewLockFreeQueue()
+
+	var elemValues []interface{}
+	for i := 0; i < dequeElemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := lockFreeQueue.PushValuesToBack(elemValues...); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+	if lockFreeQueue.GetLength() != dequeElemValuesLen {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+
+	poppedValues := lockFreeQueue.PopValuesFromFront(dequeElemValuesLen / 2)
+	popListSpace := make([]interface{}, dequeElemValuesLen)
+	poppedCount, popErr := lockFreeQueue.PopValuesFromFrontToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	poppedValues = append(poppedValues, popListSpace[:poppedCount]...)
+
+	for idx, v := range poppedValues {
+		if v != elemValues[idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if _, ok := lockFreeQueue.PopValueFromFront(); ok || !lockFreeQueue.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestLockFreeQueueConcurrent_2(t *testing.T) {
+	producerNum := 8
+	perProducerValuesLen := 10000
+
+	type elem struct {
+		producer int
+		seq      int
+	}
+	lockFreeQueue := queue.NewGenericLockFreeQueue[elem]()
+
+	var wg sync.WaitGroup
+	for p := 0; p < producerNum; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducerValuesLen; i++ {
+				_ = lockFreeQueue.PushValueToBack(elem{producer: p, seq: i})
+			}
+		}(p)
+	}
+
+	// Values of one producer must come out in the order they were pushed
+	nextSeq := make([]int, producerNum)
+	for poppedCount := 0; poppedCount < producerNum*perProducerValuesLen; {
+		value, ok := lockFreeQueue.PopValueFromFront()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		if value.seq != nextSeq[value.producer] {
+			t.Errorf("The pop-up value does not match the result, %v", value)
+			break
+		}
+		nextSeq[value.producer] += 1
+		poppedCount += 1
+	}
+	wg.Wait()
+
+	if !lockFreeQueue.IsEmpty() || lockFreeQueue.GetLength() != 0 {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestLockFreeQueueConcurrent_3(t *testing.T) {
+	producerNum := 4
+	consumerNum := 4
+	perProducerValuesLen := 10000
+	totalValuesLen := producerNum * perProducerValuesLen
+
+	lockFreeQueue := queue.NewGenericLockFreeQueue[int]()
+	poppedCh := make(chan int, totalValuesLen)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producerNum; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducerValuesLen; i++ {
+				_ = lockFreeQueue.PushValueToBack(p*perProducerValuesLen + i)
+			}
+		}(p)
+	}
+
+	var consumerWg sync.WaitGroup
+	for c := 0; c < consumerNum; c++ {
+		consumerWg.Add(1)
+		go func() {
+			defer consumerWg.Done()
+			for len(poppedCh) < totalValuesLen {
+				value, ok := lockFreeQueue.PopValueFromFront()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				poppedCh <- value
+			}
+		}()
+	}
+	wg.Wait()
+	consumerWg.Wait()
+	close(poppedCh)
+
+	seen := make([]bool, totalValuesLen)
+	for value := range poppedCh {
+		if seen[value] {
+			t.Errorf("The value %d was popped more than once", value)
+			return
+		}
+		seen[value] = true
+	}
+	for value, ok := range seen {
+		if !ok {
+			t.Errorf("The value %d was never popped", value)
+			return
+		}
+	}
+}
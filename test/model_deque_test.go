@@ -0,0 +1,224 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"math/rand"
+	"testing"
+)
+
+const (
+	dequeOpPushToBack = iota
+	dequeOpPushToFront
+	dequeOpPushValuesToBack
+	dequeOpPushValuesToFront
+	dequeOpPopFromFront
+	dequeOpPopFromBack
+	dequeOpPopValuesFromFront
+	dequeOpPopValuesFromBack
+	dequeOpPopFromFrontToListSpace
+	dequeOpPopFromBackToListSpace
+	dequeOpFilterPopFromFront
+	dequeOpFilterPopFromBack
+	dequeOpPeek
+	dequeOpCount
+)
+
+// modelDequeInst is the method set the deque model tests exercise.
+type modelDequeInst interface {
+	queue.IDeque
+	queue.Peekable
+}
+
+// runDequeOps decodes data into pairs of an operation and its argument,
+// applies them to the deque and the model and returns the first difference.
+func runDequeOps(inst queue.IDeque, maxLen int, data []byte) error {
+	d, ok := inst.(modelDequeInst)
+	if !ok {
+		return errors.New("the deque does not implement queue.Peekable")
+	}
+	model := &sliceModel{maxLen: maxLen}
+	nextValue := 0
+
+	pushValues := func(i int, toFront bool, values []any, err error) error {
+		if !model.canPush(len(values)) {
+			if len(values) == 1 && !errors.Is(err, queue.ErrFull) && !errors.Is(err, queue.ErrInsufficientCapacity) {
+				return fmt.Errorf("op %d: push on a full deque returned %v", i, err)
+			}
+			if len(values) > 1 && !errors.Is(err, queue.ErrInsufficientCapacity) {
+				return fmt.Errorf("op %d: batch push beyond the capacity returned %v", i, err)
+			}
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("op %d: push failed, %v", i, err)
+		}
+		if !toFront {
+			model.values = append(model.values, values...)
+			return nil
+		}
+		for _, value := range values {
+			model.values = append([]any{value}, model.values...)
+		}
+		return nil
+	}
+	checkPopped := func(i int, values []any, err error, want []any) error {
+		if err != nil || !equalValues(values, want) {
+			return fmt.Errorf("op %d: pop returned %v, %v, want %v", i, values, err, want)
+		}
+		return nil
+	}
+	popValue := func(value any, ok bool) []any {
+		if !ok {
+			return nil
+		}
+		return []any{value}
+	}
+	filterPop := func(arg int, popFunc func(f func(value any) bool) error) ([]any, error) {
+		var values []any
+		err := popFunc(func(value any) bool {
+			values = append(values, value)
+			return len(values) <= arg%8
+		})
+		return values, err
+	}
+
+	for i := 0; i+1 < len(data); i += 2 {
+		op, arg := int(data[i])%dequeOpCount, int(data[i+1])
+		var err error
+		switch op {
+		case dequeOpPushToBack:
+			values := newModelValues(&nextValue, 1)
+			err = pushValues(i, false, values, d.PushValueToBack(values[0]))
+		case dequeOpPushToFront:
+			values := newModelValues(&nextValue, 1)
+			err = pushValues(i, true, values, d.PushValueToFront(values[0]))
+		case dequeOpPushValuesToBack:
+			values := newModelValues(&nextValue, arg%8)
+			err = pushValues(i, false, values, d.PushValuesToBack(values...))
+		case dequeOpPushValuesToFront:
+			values := newModelValues(&nextValue, arg%8)
+			err = pushValues(i, true, values, d.PushValuesToFront(values...))
+		case dequeOpPopFromFront:
+			err = checkPopped(i, popValue(d.PopValueFromFront()), nil, model.popFront(1))
+		case dequeOpPopFromBack:
+			err = checkPopped(i, popValue(d.PopValueFromBack()), nil, model.popBack(1))
+		case dequeOpPopValuesFromFront:
+			err = checkPopped(i, d.PopValuesFromFront(arg%8), nil, model.popFront(arg%8))
+		case dequeOpPopValuesFromBack:
+			err = checkPopped(i, d.PopValuesFromBack(arg%8), nil, model.popBack(arg%8))
+		case dequeOpPopFromFrontToListSpace:
+			values, popErr := popToListSpace(arg, d.PopValuesFromFrontToListSpace)
+			err = checkPopped(i, values, popErr, model.popFront(arg%8+1))
+		case dequeOpPopFromBackToListSpace:
+			values, popErr := popToListSpace(arg, d.PopValuesFromBackToListSpace)
+			err = checkPopped(i, values, popErr, model.popBack(arg%8+1))
+		case dequeOpFilterPopFromFront:
+			values, popErr := filterPop(arg, d.PopValuesFromFrontWithFilterFunction)
+			err = checkPopped(i, values, popErr, model.popFront(arg%8+1))
+		case dequeOpFilterPopFromBack:
+			values, popErr := filterPop(arg, d.PopValuesFromBackWithFilterFunction)
+			err = checkPopped(i, values, popErr, model.popBack(arg%8+1))
+		case dequeOpPeek:
+			index := arg % (len(model.values) + 1)
+			value, ok := d.PeekAt(index)
+			if ok != (index < len(model.values)) || (ok && value != model.values[index]) {
+				err = fmt.Errorf("op %d: peek at %d returned %v, %v", i, index, value, ok)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := checkDequeState(d, model); err != nil {
+			return fmt.Errorf("op %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+func checkDequeState(d modelDequeInst, model *sliceModel) error {
+	if d.GetLength() != len(model.values) || d.IsEmpty() != (len(model.values) == 0) {
+		return fmt.Errorf("the length is %d, want %d", d.GetLength(), len(model.values))
+	}
+	if d.IsFull() != (model.available() == 0) || d.GetAvailableCapacitySize() != model.available() {
+		return fmt.Errorf("the available capacity size is %d, want %d", d.GetAvailableCapacitySize(), model.available())
+	}
+	if values := d.PeekValues(len(model.values)); !equalValues(values, model.values) {
+		return fmt.Errorf("the values are %v, want %v", values, model.values)
+	}
+	if value, ok := d.PeekBack(); ok != (len(model.values) > 0) || (ok && value != model.values[len(model.values)-1]) {
+		return fmt.Errorf("the back value is %v, %v", value, ok)
+	}
+	return nil
+}
+
+type modelDeque struct {
+	newDequeFunc func() queue.IDeque
+	maxLen       int
+}
+
+func newModelDeques(tb testing.TB) map[string]modelDeque {
+	newSafetyDeque := func(newDequeFunc func() queue.IDeque) func() queue.IDeque {
+		return func() queue.IDeque {
+			safetyDeque, newDequeErr := queue.NewSafetyDeque(newDequeFunc)
+			if newDequeErr != nil {
+				tb.Fatalf("Failed to create a safety deque, %v", newDequeErr)
+			}
+			return safetyDeque
+		}
+	}
+	newLinkListDeque := func() queue.IDeque {
+		return queue.NewLinkListDeque(modelCapacitySize)
+	}
+	newRingDeque := func() queue.IDeque {
+		return queue.NewRingDeque(modelCapacitySize)
+	}
+
+	return map[string]modelDeque{
+		"LinkListDeque": {newLinkListDeque, modelCapacitySize},
+		"UnboundedLinkListDeque": {func() queue.IDeque {
+			return queue.NewLinkListDeque(-1)
+		}, -1},
+		"RingDeque": {newRingDeque, modelCapacitySize},
+		"ShrinkableRingDeque": {func() queue.IDeque {
+			return queue.NewShrinkableRingDeque(-1)
+		}, -1},
+		"SafetyLinkListDeque": {newSafetyDeque(newLinkListDeque), modelCapacitySize},
+		"SafetyRingDeque":     {newSafetyDeque(newRingDeque), modelCapacitySize},
+	}
+}
+
+func TestDequeModel_1(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < modelRandomRounds; round++ {
+		data := newModelOpsData(rnd)
+		for name, m := range newModelDeques(t) {
+			if err := runDequeOps(m.newDequeFunc(), m.maxLen, data); err != nil {
+				t.Errorf("%s: round %d, %v", name, round, err)
+				return
+			}
+		}
+	}
+}
+
+func FuzzDequeOps(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{dequeOpPushValuesToBack, 7, dequeOpPopFromBackToListSpace, 0x82, dequeOpPushValuesToFront, 5})
+	f.Add([]byte{dequeOpPushToFront, 0, dequeOpPushToBack, 0, dequeOpFilterPopFromBack, 1, dequeOpPopValuesFromFront, 3})
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 4; i++ {
+		f.Add(newModelOpsData(rnd))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for name, m := range newModelDeques(t) {
+			if err := runDequeOps(m.newDequeFunc(), m.maxLen, data); err != nil {
+				t.Errorf("%s: %v", name, err)
+				return
+			}
+		}
+	})
+}
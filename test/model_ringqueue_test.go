@@ -0,0 +1,300 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"math/rand"
+	"testing"
+)
+
+const (
+	modelCapacitySize   = 9
+	modelRandomRounds   = 200
+	modelRandomOpsBytes = 512
+)
+
+const (
+	ringQueueOpPush = iota
+	ringQueueOpPushValues
+	ringQueueOpPop
+	ringQueueOpPopValues
+	ringQueueOpPopToListSpace
+	ringQueueOpFilterPop
+	ringQueueOpPeek
+	ringQueueOpRemoveAt
+	ringQueueOpInsertAt
+	ringQueueOpCount
+)
+
+// sliceModel is the reference every queue is compared with, maxLen is the
+// number of values the queue can hold and is negative for unbounded queues.
+type sliceModel struct {
+	values []any
+	maxLen int
+}
+
+func (t *sliceModel) available() int {
+	if t.maxLen < 0 {
+		return -1
+	}
+	return t.maxLen - len(t.values)
+}
+
+func (t *sliceModel) canPush(count int) bool {
+	return t.maxLen < 0 || len(t.values)+count <= t.maxLen
+}
+
+func (t *sliceModel) popFront(count int) []any {
+	if count > len(t.values) {
+		count = len(t.values)
+	}
+	popped := append([]any{}, t.values[:count]...)
+	t.values = t.values[count:]
+	return popped
+}
+
+func (t *sliceModel) popBack(count int) []any {
+	var popped []any
+	for ; count > 0 && len(t.values) > 0; count-- {
+		popped = append(popped, t.values[len(t.values)-1])
+		t.values = t.values[:len(t.values)-1]
+	}
+	return popped
+}
+
+func equalValues(a, b []any) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func newModelOpsData(rnd *rand.Rand) []byte {
+	data := make([]byte, modelRandomOpsBytes)
+	rnd.Read(data)
+	return data
+}
+
+// popToListSpace pops into a list space with a length when the high bit of arg
+// is set and into an empty one with a capacity otherwise.
+func popToListSpace(arg int, popFunc func(ptrListSpace *[]any) (int, error)) ([]any, error) {
+	size := arg%8 + 1
+	if arg&0x80 != 0 {
+		listSpace := make([]any, size)
+		count, err := popFunc(&listSpace)
+		return listSpace[:count], err
+	}
+
+	listSpace := make([]any, 0, size)
+	_, err := popFunc(&listSpace)
+	return listSpace, err
+}
+
+func newModelValues(nextValue *int, count int) []any {
+	values := make([]any, 0, count)
+	for i := 0; i < count; i++ {
+		values = append(values, *nextValue)
+		*nextValue += 1
+	}
+	return values
+}
+
+// modelRingQueueInst is the method set the ring queue model tests exercise.
+type modelRingQueueInst interface {
+	queue.IRingQueue
+	queue.Peekable
+	queue.Indexable
+}
+
+// runRingQueueOps decodes data into pairs of an operation and its argument,
+// applies them to the queue and the model and returns the first difference.
+func runRingQueueOps(inst queue.IRingQueue, maxLen int, data []byte) error {
+	q, ok := inst.(modelRingQueueInst)
+	if !ok {
+		return errors.New("the queue does not implement the optional ring queue interfaces")
+	}
+	model := &sliceModel{maxLen: maxLen}
+	nextValue := 0
+
+	for i := 0; i+1 < len(data); i += 2 {
+		op, arg := int(data[i])%ringQueueOpCount, int(data[i+1])
+		switch op {
+		case ringQueueOpPush:
+			value := newModelValues(&nextValue, 1)[0]
+			err := q.PushValue(value)
+			if model.canPush(1) {
+				if err != nil {
+					return fmt.Errorf("op %d: push failed, %v", i, err)
+				}
+				model.values = append(model.values, value)
+			} else if !errors.Is(err, queue.ErrFull) {
+				return fmt.Errorf("op %d: push on a full queue returned %v", i, err)
+			}
+		case ringQueueOpPushValues:
+			values := newModelValues(&nextValue, arg%8)
+			err := q.PushValues(values...)
+			if model.canPush(len(values)) {
+				if err != nil {
+					return fmt.Errorf("op %d: batch push failed, %v", i, err)
+				}
+				model.values = append(model.values, values...)
+			} else if !errors.Is(err, queue.ErrInsufficientCapacity) {
+				return fmt.Errorf("op %d: batch push beyond the capacity returned %v", i, err)
+			}
+		case ringQueueOpPop:
+			value, ok := q.PopValue()
+			want := model.popFront(1)
+			if ok != (len(want) == 1) || (ok && value != want[0]) {
+				return fmt.Errorf("op %d: pop returned %v, %v, want %v", i, value, ok, want)
+			}
+		case ringQueueOpPopValues:
+			values := q.PopValues(arg % 8)
+			if want := model.popFront(arg % 8); !equalValues(values, want) {
+				return fmt.Errorf("op %d: batch pop returned %v, want %v", i, values, want)
+			}
+		case ringQueueOpPopToListSpace:
+			values, err := popToListSpace(arg, q.PopValuesToListSpace)
+			if want := model.popFront(arg%8 + 1); err != nil || !equalValues(values, want) {
+				return fmt.Errorf("op %d: list space pop returned %v, %v, want %v", i, values, err, want)
+			}
+		case ringQueueOpFilterPop:
+			var values []any
+			err := q.PopValuesWithFilterFunction(func(value any) bool {
+				values = append(values, value)
+				return len(values) <= arg%8
+			})
+			if want := model.popFront(arg%8 + 1); err != nil || !equalValues(values, want) {
+				return fmt.Errorf("op %d: filter pop returned %v, %v, want %v", i, values, err, want)
+			}
+		case ringQueueOpPeek:
+			index := arg % (len(model.values) + 1)
+			value, ok := q.PeekAt(index)
+			if ok != (index < len(model.values)) || (ok && value != model.values[index]) {
+				return fmt.Errorf("op %d: peek at %d returned %v, %v", i, index, value, ok)
+			}
+		case ringQueueOpRemoveAt:
+			index := arg%(len(model.values)+2) - 1
+			value, err := q.RemoveAt(index)
+			if index < 0 || index >= len(model.values) {
+				if !errors.Is(err, queue.ErrIndexOutOfRange) {
+					return fmt.Errorf("op %d: remove at %d returned %v", i, index, err)
+				}
+				break
+			}
+			if err != nil || value != model.values[index] {
+				return fmt.Errorf("op %d: remove at %d returned %v, %v", i, index, value, err)
+			}
+			model.values = append(model.values[:index], model.values[index+1:]...)
+		case ringQueueOpInsertAt:
+			index := arg%(len(model.values)+3) - 1
+			value := newModelValues(&nextValue, 1)[0]
+			err := q.InsertAt(index, value)
+			if index < 0 || index > len(model.values) {
+				if !errors.Is(err, queue.ErrIndexOutOfRange) {
+					return fmt.Errorf("op %d: insert at %d returned %v", i, index, err)
+				}
+				break
+			}
+			if !model.canPush(1) {
+				if !errors.Is(err, queue.ErrFull) {
+					return fmt.Errorf("op %d: insert on a full queue returned %v", i, err)
+				}
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("op %d: insert at %d failed, %v", i, index, err)
+			}
+			model.values = append(model.values[:index], append([]any{value}, model.values[index:]...)...)
+		}
+
+		if err := checkRingQueueState(q, model); err != nil {
+			return fmt.Errorf("op %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+func checkRingQueueState(q modelRingQueueInst, model *sliceModel) error {
+	if q.GetLength() != len(model.values) || q.IsEmpty() != (len(model.values) == 0) {
+		return fmt.Errorf("the length is %d, want %d", q.GetLength(), len(model.values))
+	}
+	if q.IsFull() != (model.available() == 0) || q.GetAvailableCapacitySize() != model.available() {
+		return fmt.Errorf("the available capacity size is %d, want %d", q.GetAvailableCapacitySize(), model.available())
+	}
+	if values := q.PeekValues(len(model.values)); !equalValues(values, model.values) {
+		return fmt.Errorf("the values are %v, want %v", values, model.values)
+	}
+	return nil
+}
+
+type modelRingQueue struct {
+	newQueueFunc func() queue.IRingQueue
+	maxLen       int
+}
+
+func newModelRingQueues(tb testing.TB) map[string]modelRingQueue {
+	newSafetyRingQueue := func(newQueueFunc func() queue.IRingQueue) func() queue.IRingQueue {
+		return func() queue.IRingQueue {
+			safetyQueue, newQueueErr := queue.NewSafetyRingDeque(newQueueFunc)
+			if newQueueErr != nil {
+				tb.Fatalf("Failed to create a safety ring queue, %v", newQueueErr)
+			}
+			return safetyQueue
+		}
+	}
+	newRingQueue := func() queue.IRingQueue {
+		return queue.NewRingQueue(modelCapacitySize)
+	}
+	newAutoResizingRingQueue := func() queue.IRingQueue {
+		return queue.NewAutoResizingRingQueue(2, modelCapacitySize)
+	}
+
+	return map[string]modelRingQueue{
+		"RingQueue":             {newRingQueue, modelCapacitySize - 1},
+		"AutoResizingRingQueue": {newAutoResizingRingQueue, modelCapacitySize - 1},
+		"UnboundedRingQueue": {func() queue.IRingQueue {
+			return queue.NewAutoResizingRingQueue(2, -1)
+		}, -1},
+		"SafetyRingQueue":             {newSafetyRingQueue(newRingQueue), modelCapacitySize - 1},
+		"SafetyAutoResizingRingQueue": {newSafetyRingQueue(newAutoResizingRingQueue), modelCapacitySize - 1},
+	}
+}
+
+func TestRingQueueModel_1(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < modelRandomRounds; round++ {
+		data := newModelOpsData(rnd)
+		for name, m := range newModelRingQueues(t) {
+			if err := runRingQueueOps(m.newQueueFunc(), m.maxLen, data); err != nil {
+				t.Errorf("%s: round %d, %v", name, round, err)
+				return
+			}
+		}
+	}
+}
+
+func FuzzRingQueueOps(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{ringQueueOpPushValues, 7, ringQueueOpPop, 0, ringQueueOpPushValues, 7, ringQueueOpRemoveAt, 3})
+	f.Add([]byte{ringQueueOpPush, 0, ringQueueOpInsertAt, 1, ringQueueOpPopToListSpace, 0x83, ringQueueOpFilterPop, 2})
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 4; i++ {
+		f.Add(newModelOpsData(rnd))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for name, m := range newModelRingQueues(t) {
+			if err := runRingQueueOps(m.newQueueFunc(), m.maxLen, data); err != nil {
+				t.Errorf("%s: %v", name, err)
+				return
+			}
+		}
+	})
+}
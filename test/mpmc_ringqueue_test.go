@@ -0,0 +1,130 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMPMCRingQueuePushValues_1(t *testing.T) {
+	ringQueueCapacitySize := 16
+
+	var ringQueue queue.IRingQueue = queue.NewMPMCRingQueue(ringQueueCapacitySize)
+
+	var elemValues []interface{}
+	for i := 0; i < ringQueueCapacitySize; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := ringQueue.PushValues(elemValues[:ringQueueCapacitySize/2]...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if err := ringQueue.PushValues(elemValues...); !errors.Is(err, queue.ErrInsufficientCapacity) {
+		t.Errorf("The error is not ErrInsufficientCapacity, %v", err)
+		return
+	}
+	if err := ringQueue.PushValues(elemValues[ringQueueCapacitySize/2:]...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if err := ringQueue.PushValue(0); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+
+	var poppedCount int
+	if err := ringQueue.PopValuesWithFilterFunction(func(value interface{}) bool {
+		if value != elemValues[poppedCount] {
+			t.Error("The pop-up value does not match the result")
+			return false
+		}
+		poppedCount += 1
+		return true
+	}); err != nil {
+		t.Errorf("Failed to pop values to function, %v", err)
+		return
+	}
+
+	if poppedCount != ringQueueCapacitySize || !ringQueue.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestMPMCRingQueueStress_2(t *testing.T) {
+	ringQueueCapacitySize := 64
+	producerNum := 4
+	consumerNum := 4
+	perProducerValuesLen := 20000
+	totalValuesLen := producerNum * perProducerValuesLen
+
+	ringQueue := queue.NewGenericMPMCRingQueue[int](ringQueueCapacitySize)
+	seen := make([]int32, totalValuesLen)
+	var poppedCount int64
+
+	var producerWg sync.WaitGroup
+	for p := 0; p < producerNum; p++ {
+		producerWg.Add(1)
+		go func(p int) {
+			defer producerWg.Done()
+			base := p * perProducerValuesLen
+			for i := 0; i < perProducerValuesLen; {
+				if i%5 == 0 && i+3 <= perProducerValuesLen {
+					if ringQueue.PushValues(base+i, base+i+1, base+i+2) == nil {
+						i += 3
+						continue
+					}
+				} else if ringQueue.PushValue(base+i) == nil {
+					i += 1
+					continue
+				}
+				runtime.Gosched()
+			}
+		}(p)
+	}
+
+	var consumerWg sync.WaitGroup
+	for c := 0; c < consumerNum; c++ {
+		consumerWg.Add(1)
+		go func(c int) {
+			defer consumerWg.Done()
+			listSpace := make([]int, 0, 4)
+			for atomic.LoadInt64(&poppedCount) < int64(totalValuesLen) {
+				var values []int
+				if c%2 == 0 {
+					listSpace = listSpace[:0]
+					count, _ := ringQueue.PopValuesToListSpace(&listSpace)
+					values = listSpace[:count]
+				} else if value, ok := ringQueue.PopValue(); ok {
+					values = []int{value}
+				}
+				if len(values) == 0 {
+					runtime.Gosched()
+					continue
+				}
+				for _, value := range values {
+					atomic.AddInt32(&seen[value], 1)
+				}
+				atomic.AddInt64(&poppedCount, int64(len(values)))
+			}
+		}(c)
+	}
+
+	producerWg.Wait()
+	consumerWg.Wait()
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Errorf("The value %d was popped %d times", value, count)
+			return
+		}
+	}
+	if !ringQueue.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
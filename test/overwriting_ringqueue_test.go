@@ -0,0 +1,132 @@
+package test
+
+import (
+	"context"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+	"time"
+)
+
+func TestOverwritingRingQueuePushValues_1(t *testing.T) {
+	ringQueueCapacitySize := 6
+	ringQueueElemValuesLen := 20
+
+	var evictedValues []int
+	ringQueue := queue.NewGenericOverwritingRingQueue[int](ringQueueCapacitySize, func(value int) {
+		evictedValues = append(evictedValues, value)
+	})
+
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		if err := ringQueue.PushValue(i); err != nil {
+			t.Errorf("Failed to push the value to ring queue, %v", err)
+			return
+		}
+	}
+
+	keptLen := ringQueueCapacitySize - 1
+	if ringQueue.GetLength() != keptLen || len(evictedValues) != ringQueueElemValuesLen-keptLen {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+	for idx, v := range evictedValues {
+		if v != idx {
+			t.Error("The evicted value does not match the result")
+			return
+		}
+	}
+
+	poppedValues := ringQueue.PopValues(ringQueueElemValuesLen)
+	for idx, v := range poppedValues {
+		if v != ringQueueElemValuesLen-keptLen+idx {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
+
+func TestOverwritingRingQueuePushValues_2(t *testing.T) {
+	ringQueueCapacitySize := 6
+	ringQueueElemValuesLen := 20
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewOverwritingRingQueue(ringQueueCapacitySize, nil)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	var elemValues []interface{}
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := safetyQueue.PushValues(elemValues[:3]...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if err := safetyQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	// A full overwriting queue must not block the producer
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := safetyQueue.PushValueWait(ctx, ringQueueElemValuesLen); err != nil {
+		t.Errorf("Failed to push the value to ring queue, %v", err)
+		return
+	}
+
+	keptLen := ringQueueCapacitySize - 1
+	poppedValues := safetyQueue.PopValues(ringQueueElemValuesLen)
+	if len(poppedValues) != keptLen {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+	for idx, v := range poppedValues {
+		if v != ringQueueElemValuesLen+1-keptLen+idx {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
+
+func TestOverwritingRingQueuePushValues_3(t *testing.T) {
+	ringQueueCapacitySize := 6
+	ringQueueElemValuesLen := 20
+
+	var safetyQueue *queue.SafetyRingQueue
+	var evictedValues []interface{}
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		// The callback uses the wrapper, which deadlocks if it runs under its lock
+		return queue.NewOverwritingRingQueue(ringQueueCapacitySize, func(value any) {
+			if safetyQueue.IsFull() {
+				evictedValues = append(evictedValues, value)
+			}
+		})
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	for i := 0; i < ringQueueElemValuesLen; i++ {
+		if err := safetyQueue.PushValue(i); err != nil {
+			t.Errorf("Failed to push the value to ring queue, %v", err)
+			return
+		}
+	}
+
+	keptLen := ringQueueCapacitySize - 1
+	if len(evictedValues) != ringQueueElemValuesLen-keptLen {
+		t.Error("Wrong number of evicted values")
+		return
+	}
+	for idx, v := range evictedValues {
+		if v != idx {
+			t.Error("The evicted value does not match the result")
+			return
+		}
+	}
+}
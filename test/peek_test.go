@@ -0,0 +1,199 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func checkRingQueuePeekValues(t *testing.T, ringQueue interface {
+	queue.Sizer
+	queue.Peekable
+}, elemValues []interface{}) bool {
+	if value, ok := ringQueue.PeekValue(); !ok || value != elemValues[0] {
+		t.Error("The peeked value does not match the result")
+		return false
+	}
+	if value, ok := ringQueue.PeekFront(); !ok || value != elemValues[0] {
+		t.Error("The peeked front value does not match the result")
+		return false
+	}
+	if value, ok := ringQueue.PeekBack(); !ok || value != elemValues[len(elemValues)-1] {
+		t.Error("The peeked back value does not match the result")
+		return false
+	}
+	for idx, v := range elemValues {
+		if value, ok := ringQueue.PeekAt(idx); !ok || value != v {
+			t.Error("The value peeked at the index does not match the result")
+			return false
+		}
+	}
+	if _, ok := ringQueue.PeekAt(len(elemValues)); ok {
+		t.Error("Peeking out of range returned a value")
+		return false
+	}
+
+	peekedValues := ringQueue.PeekValues(len(elemValues) + 1)
+	if len(peekedValues) != len(elemValues) {
+		t.Error("The number of peeked values is incorrect")
+		return false
+	}
+	for idx, v := range peekedValues {
+		if v != elemValues[idx] {
+			t.Error("The peeked values do not match the result")
+			return false
+		}
+	}
+
+	if ringQueue.GetLength() != len(elemValues) {
+		t.Error("Peeking must not remove values")
+		return false
+	}
+	return true
+}
+
+func TestRingQueuePeekValues_1(t *testing.T) {
+	ringQueueCapacitySize := 8
+
+	newRingQueueFuncs := map[string]func() queue.IRingQueue{
+		"RingQueue": func() queue.IRingQueue {
+			return queue.NewRingQueue(ringQueueCapacitySize)
+		},
+		"SPSCRingQueue": func() queue.IRingQueue {
+			return queue.NewSPSCRingQueue(ringQueueCapacitySize - 1)
+		},
+		"PriorityQueue": func() queue.IRingQueue {
+			return newIntPriorityQueue(t, ringQueueCapacitySize-1)
+		},
+	}
+
+	for name, newRingQueueFunc := range newRingQueueFuncs {
+		safetyQueue, newQueueErr := queue.NewSafetyRingDeque(newRingQueueFunc)
+		if newQueueErr != nil {
+			t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+			return
+		}
+
+		if _, ok := safetyQueue.PeekValue(); ok {
+			t.Errorf("%s: peeking an empty queue returned a value", name)
+			return
+		}
+
+		// Wrap around the ring before peeking
+		var elemValues []interface{}
+		for i := 0; i < ringQueueCapacitySize-1; i++ {
+			elemValues = append(elemValues, i)
+		}
+		if err := safetyQueue.PushValues(elemValues[:4]...); err != nil {
+			t.Errorf("%s: failed to push values to the ring queue, %v", name, err)
+			return
+		}
+		safetyQueue.PopValues(4)
+		if err := safetyQueue.PushValues(elemValues...); err != nil {
+			t.Errorf("%s: failed to push values to the ring queue, %v", name, err)
+			return
+		}
+
+		if !checkRingQueuePeekValues(t, safetyQueue, elemValues) {
+			t.Errorf("%s: peek check failed", name)
+			return
+		}
+	}
+}
+
+func TestMPMCRingQueuePeekValues_2(t *testing.T) {
+	var ringQueue queue.IRingQueue = queue.NewMPMCRingQueue(4)
+	if _, ok := ringQueue.(queue.Peekable); ok {
+		t.Error("The MPMC ring queue can not peek but implements queue.Peekable")
+		return
+	}
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return ringQueue
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValue(1); err != nil {
+		t.Errorf("Failed to push the value to ring queue, %v", err)
+		return
+	}
+
+	if _, ok := safetyQueue.PeekValue(); ok {
+		t.Error("Peeking the wrapped MPMC ring queue returned a value")
+		return
+	}
+	if _, ok := safetyQueue.PeekAt(0); ok {
+		t.Error("Peeking the wrapped MPMC ring queue returned a value")
+		return
+	}
+	if values := safetyQueue.PeekValues(1); len(values) != 0 {
+		t.Errorf("Peeking the wrapped MPMC ring queue returned values, %v", values)
+		return
+	}
+	if safetyQueue.GetLength() != 1 {
+		t.Error("Peeking must not remove values")
+		return
+	}
+}
+
+func TestDequePeekValues_3(t *testing.T) {
+	newDequeFuncs := map[string]func() queue.IDeque{
+		"LinkListDeque": func() queue.IDeque {
+			return queue.NewLinkListDeque(-1)
+		},
+		"RingDeque": func() queue.IDeque {
+			return queue.NewRingDeque(-1)
+		},
+	}
+
+	for name, newDequeFunc := range newDequeFuncs {
+		safetyQueue, newQueueErr := queue.NewSafetyDeque(newDequeFunc)
+		if newQueueErr != nil {
+			t.Errorf("Failed to create a safety dual end queue, %v", newQueueErr)
+			return
+		}
+
+		var elemValues []interface{}
+		for i := 0; i < dequeElemValuesLen; i++ {
+			elemValues = append(elemValues, i)
+		}
+		for i := dequeElemValuesLen/2 - 1; i >= 0; i-- {
+			if err := safetyQueue.PushValueToFront(elemValues[i]); err != nil {
+				t.Errorf("%s: failed to push the value to queue front, %v", name, err)
+				return
+			}
+		}
+		if err := safetyQueue.PushValuesToBack(elemValues[dequeElemValuesLen/2:]...); err != nil {
+			t.Errorf("%s: failed to push the value to queue back, %v", name, err)
+			return
+		}
+
+		for idx, v := range elemValues {
+			if value, ok := safetyQueue.PeekAt(idx); !ok || value != v {
+				t.Errorf("%s: the value peeked at the index does not match the result", name)
+				return
+			}
+		}
+		if value, ok := safetyQueue.PeekFront(); !ok || value != elemValues[0] {
+			t.Errorf("%s: the peeked front value does not match the result", name)
+			return
+		}
+		if value, ok := safetyQueue.PeekBack(); !ok || value != elemValues[dequeElemValuesLen-1] {
+			t.Errorf("%s: the peeked back value does not match the result", name)
+			return
+		}
+
+		peekedValues := safetyQueue.PeekValues(dequeElemValuesLen / 3)
+		for idx, v := range peekedValues {
+			if v != elemValues[idx] {
+				t.Errorf("%s: the peeked values do not match the result", name)
+				return
+			}
+		}
+		if len(peekedValues) != dequeElemValuesLen/3 || safetyQueue.GetLength() != dequeElemValuesLen {
+			t.Errorf("%s: peeking must not remove values", name)
+			return
+		}
+	}
+}
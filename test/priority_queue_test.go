@@ -0,0 +1,171 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newIntPriorityQueue creates a priority queue of ints that pops the smallest first.
+func newIntPriorityQueue(tb testing.TB, capacity int) *queue.PriorityQueue {
+	tb.Helper()
+	priorityQueue, err := queue.NewPriorityQueue(capacity, func(a, b any) bool {
+		return a.(int) < b.(int)
+	})
+	if err != nil {
+		tb.Fatalf("Failed to create a priority queue, %v", err)
+	}
+	return priorityQueue
+}
+
+func TestPriorityQueuePushValues_1(t *testing.T) {
+	elemValuesLen := 200
+
+	priorityQueue, newQueueErr := queue.NewGenericPriorityQueue[int](-1, func(a, b int) bool {
+		return a < b
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a priority queue, %v", newQueueErr)
+		return
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	var elemValues []int
+	for i := 0; i < elemValuesLen; i++ {
+		elemValues = append(elemValues, rnd.Intn(elemValuesLen/2))
+	}
+
+	if err := priorityQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push values to the priority queue, %v", err)
+		return
+	}
+	sort.Ints(elemValues)
+
+	if value, ok := priorityQueue.PeekValue(); !ok || value != elemValues[0] {
+		t.Error("The peeked value does not match the result")
+		return
+	}
+	if priorityQueue.GetLength() != elemValuesLen {
+		t.Error("Peeking must not remove the value")
+		return
+	}
+
+	poppedValues := priorityQueue.PopValues(elemValuesLen / 2)
+	popListSpace := make([]int, elemValuesLen/4)
+	poppedCount, popErr := priorityQueue.PopValuesToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	poppedValues = append(poppedValues, popListSpace[:poppedCount]...)
+	if err := priorityQueue.PopValuesWithFilterFunction(func(value int) bool {
+		poppedValues = append(poppedValues, value)
+		return true
+	}); err != nil {
+		t.Errorf("Failed to pop values to function, %v", err)
+		return
+	}
+
+	if len(poppedValues) != elemValuesLen {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+	for idx, v := range poppedValues {
+		if v != elemValues[idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
+
+func TestPriorityQueuePushValuesWithException_2(t *testing.T) {
+	priorityQueueCapacitySize := 3
+
+	priorityQueue, newQueueErr := queue.NewPriorityQueue(priorityQueueCapacitySize, func(a, b any) bool {
+		return a.(int) > b.(int)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a priority queue, %v", newQueueErr)
+		return
+	}
+
+	err := priorityQueue.PushValues(1, 2, 3, 4)
+	var capErr *queue.CapacityError
+	if !errors.As(err, &capErr) || capErr.Requested != 4 || capErr.Available != priorityQueueCapacitySize {
+		t.Errorf("The error is not a valid capacity error, %v", err)
+		return
+	}
+
+	if err := priorityQueue.PushValues(1, 3, 2); err != nil {
+		t.Errorf("Failed to push values to the priority queue, %v", err)
+		return
+	}
+	if err := priorityQueue.PushValue(4); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+
+	if value, ok := priorityQueue.PopValue(); !ok || value != 3 {
+		t.Error("The pop-up value does not match the result")
+		return
+	}
+
+	if _, err := queue.NewPriorityQueue(priorityQueueCapacitySize, nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("The error is not ErrNilFunc, %v", err)
+		return
+	}
+}
+
+func TestSafetyPriorityQueueWait_3(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyPriorityQueue(func() *queue.PriorityQueue {
+		return newIntPriorityQueue(t, 2)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety priority queue, %v", newQueueErr)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, v := range []int{5, 1, 4, 2, 3} {
+			if err := safetyQueue.PushValueWait(ctx, v); err != nil {
+				t.Errorf("Failed to push the value to priority queue, %v", err)
+				return
+			}
+		}
+		_ = safetyQueue.Close()
+	}()
+
+	var poppedValues []int
+	for {
+		value, err := safetyQueue.PopValueWait(ctx)
+		if errors.Is(err, queue.ErrClosed) {
+			break
+		}
+		if err != nil {
+			t.Errorf("Failed to pop the value from priority queue, %v", err)
+			break
+		}
+		poppedValues = append(poppedValues, value.(int))
+	}
+	wg.Wait()
+
+	if len(poppedValues) != 5 {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+	if _, ok := safetyQueue.PeekValue(); ok {
+		t.Error("Peeking a drained queue returned a value")
+		return
+	}
+}
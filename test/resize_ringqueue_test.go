@@ -0,0 +1,192 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func TestRingQueueResize_1(t *testing.T) {
+	ringQueue := queue.NewGenericRingQueue[int](6)
+	if err := ringQueue.PushValues(-1, -1, -1); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	ringQueue.PopValues(3)
+	if err := ringQueue.PushValues(0, 1, 2, 3, 4); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	var capErr *queue.CapacityError
+	if err := ringQueue.Resize(5); !errors.As(err, &capErr) || capErr.Requested != 5 || capErr.Available != 4 {
+		t.Errorf("Failed to reject shrinking below the length, %v", err)
+		return
+	}
+
+	if err := ringQueue.Resize(12); err != nil {
+		t.Errorf("Failed to grow the ring queue, %v", err)
+		return
+	}
+	if ringQueue.GetCapacity() != 12 || ringQueue.GetAvailableCapacitySize() != 6 {
+		t.Error("The capacity of the ring queue is incorrect after growing")
+		return
+	}
+	if err := ringQueue.PushValues(5, 6, 7, 8, 9, 10); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if !ringQueue.IsFull() {
+		t.Error("The ring queue is expected to be full")
+		return
+	}
+
+	ringQueue.PopValues(8)
+	if err := ringQueue.Resize(4); err != nil {
+		t.Errorf("Failed to shrink the ring queue, %v", err)
+		return
+	}
+	poppedValues := ringQueue.PopValues(4)
+	if len(poppedValues) != 3 || poppedValues[0] != 8 || poppedValues[1] != 9 || poppedValues[2] != 10 {
+		t.Errorf("The values are not kept in order after resizing, %v", poppedValues)
+		return
+	}
+}
+
+func TestAutoResizingRingQueue_1(t *testing.T) {
+	ringQueueCapacitySize := 4
+	ringQueueMaxCapacitySize := 32
+
+	ringQueue := queue.NewGenericAutoResizingRingQueue[int](ringQueueCapacitySize, ringQueueMaxCapacitySize)
+	for i := 0; i < ringQueueMaxCapacitySize-1; i++ {
+		if err := ringQueue.PushValue(i); err != nil {
+			t.Errorf("Failed to push the value to the ring queue, %v", err)
+			return
+		}
+	}
+	if ringQueue.GetCapacity() != ringQueueMaxCapacitySize || !ringQueue.IsFull() {
+		t.Error("The ring queue is expected to grow up to the max capacity")
+		return
+	}
+	if err := ringQueue.PushValue(-1); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("Failed to reject a push beyond the max capacity, %v", err)
+		return
+	}
+
+	for i := 0; i < ringQueueMaxCapacitySize-1; i++ {
+		value, ok := ringQueue.PopValue()
+		if !ok || value != i {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+	if ringQueue.GetCapacity() != ringQueueCapacitySize {
+		t.Errorf("The ring queue is expected to shrink to the initial capacity, %d", ringQueue.GetCapacity())
+		return
+	}
+
+	unboundedQueue := queue.NewAutoResizingRingQueue(ringQueueCapacitySize, -1)
+	var elemValues []interface{}
+	for i := 0; i < 100; i++ {
+		elemValues = append(elemValues, i)
+	}
+	if err := unboundedQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push values to the unbounded ring queue, %v", err)
+		return
+	}
+	if unboundedQueue.IsFull() || unboundedQueue.GetAvailableCapacitySize() >= 0 || unboundedQueue.GetLength() != len(elemValues) {
+		t.Error("The unbounded ring queue state is incorrect")
+		return
+	}
+}
+
+func TestAutoResizingRingQueueWithException_2(t *testing.T) {
+	// A zero capacity is raised so the queue can hold values and grow
+	ringQueue := queue.NewGenericAutoResizingRingQueue[int](0, -1)
+	if ringQueue.IsFull() || ringQueue.GetLength() != 0 {
+		t.Error("The empty ring queue state is incorrect")
+		return
+	}
+	for i := 0; i < 10; i++ {
+		if err := ringQueue.PushValue(i); err != nil {
+			t.Errorf("Failed to push the value to the ring queue, %v", err)
+			return
+		}
+	}
+	if poppedValues := ringQueue.PopValues(10); len(poppedValues) != 10 || poppedValues[9] != 9 {
+		t.Errorf("The pop-up values do not match the result, %v", poppedValues)
+		return
+	}
+
+	boundedQueue := queue.NewGenericAutoResizingRingQueue[int](0, 0)
+	if err := boundedQueue.PushValue(0); err != nil {
+		t.Errorf("Failed to push the value to the ring queue, %v", err)
+		return
+	}
+	if !boundedQueue.IsFull() || boundedQueue.GetAvailableCapacitySize() != 0 {
+		t.Error("The bounded ring queue is expected to be full")
+		return
+	}
+}
+
+func TestAutoResizingRingQueueResize_3(t *testing.T) {
+	ringQueue := queue.NewGenericAutoResizingRingQueue[int](4, 8)
+	if err := ringQueue.Resize(32); err != nil {
+		t.Errorf("Failed to grow the ring queue, %v", err)
+		return
+	}
+
+	var elemValues []int
+	for i := 0; i < 20; i++ {
+		elemValues = append(elemValues, i)
+	}
+	if err := ringQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if ringQueue.GetAvailableCapacitySize() != 11 {
+		t.Errorf("The available capacity size is incorrect, %d", ringQueue.GetAvailableCapacitySize())
+		return
+	}
+
+	// A batch beyond the capacity is rejected as a whole
+	var capErr *queue.CapacityError
+	if err := ringQueue.PushValues(elemValues...); !errors.As(err, &capErr) || capErr.Available != 11 {
+		t.Errorf("Failed to reject pushing beyond the capacity, %v", err)
+		return
+	}
+	if ringQueue.GetLength() != len(elemValues) {
+		t.Error("A rejected batch push changed the length of the ring queue")
+		return
+	}
+	if err := ringQueue.PushValues(elemValues[:11]...); err != nil || !ringQueue.IsFull() {
+		t.Errorf("Failed to fill the ring queue, %v", err)
+		return
+	}
+}
+
+func TestSafetyRingQueueResize_1(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(3)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValues(0, 1); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	spscQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewSPSCRingQueue(4)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := spscQueue.Resize(8); !errors.Is(err, queue.ErrUnsupportedOperation) {
+		t.Errorf("Failed to reject resizing an unsupported queue, %v", err)
+		return
+	}
+}
@@ -0,0 +1,193 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func checkRingQueueValues(t *testing.T, safetyQueue *queue.SafetyRingQueue, expectedValues []interface{}) bool {
+	if safetyQueue.GetLength() != len(expectedValues) {
+		t.Errorf("Wrong number of elements, %d != %d", safetyQueue.GetLength(), len(expectedValues))
+		return false
+	}
+	for idx, v := range expectedValues {
+		value, err := safetyQueue.Get(idx)
+		if err != nil || value != v {
+			t.Errorf("The value at index %d does not match the result, %v", idx, err)
+			return false
+		}
+	}
+	return true
+}
+
+func TestSafetyRingQueueIndexAccess_1(t *testing.T) {
+	ringQueueCapacitySize := 8
+
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(ringQueueCapacitySize)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+
+	// Move the front so that the logical contents wrap around the backing slice
+	if err := safetyQueue.PushValues(0, 0, 0, 0, 0); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	safetyQueue.PopValues(5)
+	if err := safetyQueue.PushValues(1, 2, 3, 4, 5); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+
+	if err := safetyQueue.Set(1, 20); err != nil {
+		t.Errorf("Failed to set the value, %v", err)
+		return
+	}
+	if !checkRingQueueValues(t, safetyQueue, []interface{}{1, 20, 3, 4, 5}) {
+		return
+	}
+
+	if value, err := safetyQueue.RemoveAt(2); err != nil || value != 3 {
+		t.Errorf("Failed to remove the value, %v", err)
+		return
+	}
+	if !checkRingQueueValues(t, safetyQueue, []interface{}{1, 20, 4, 5}) {
+		return
+	}
+
+	for _, args := range [][2]int{{0, 10}, {2, 30}, {6, 60}} {
+		if err := safetyQueue.InsertAt(args[0], args[1]); err != nil {
+			t.Errorf("Failed to insert the value, %v", err)
+			return
+		}
+	}
+	if !checkRingQueueValues(t, safetyQueue, []interface{}{10, 1, 30, 20, 4, 5, 60}) {
+		return
+	}
+	if err := safetyQueue.InsertAt(0, 0); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+
+	if value, err := safetyQueue.RemoveAt(6); err != nil || value != 60 {
+		t.Errorf("Failed to remove the value, %v", err)
+		return
+	}
+	poppedValues := safetyQueue.PopValues(ringQueueCapacitySize)
+	expectedValues := []interface{}{10, 1, 30, 20, 4, 5}
+	for idx, v := range expectedValues {
+		if poppedValues[idx] != v {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
+
+func TestSafetyRingQueueIndexAccessWithException_2(t *testing.T) {
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return queue.NewRingQueue(4)
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.PushValue(0); err != nil {
+		t.Errorf("Failed to push the value to ring queue, %v", err)
+		return
+	}
+
+	var indexErr *queue.IndexError
+	if _, err := safetyQueue.Get(1); !errors.As(err, &indexErr) || indexErr.Index != 1 || indexErr.Length != 1 {
+		t.Errorf("The error is not a valid index error, %v", err)
+		return
+	}
+	if err := safetyQueue.Set(-1, 0); !errors.Is(err, queue.ErrIndexOutOfRange) {
+		t.Errorf("The error is not ErrIndexOutOfRange, %v", err)
+		return
+	}
+	if _, err := safetyQueue.RemoveAt(1); !errors.Is(err, queue.ErrIndexOutOfRange) {
+		t.Errorf("The error is not ErrIndexOutOfRange, %v", err)
+		return
+	}
+	if err := safetyQueue.InsertAt(2, 0); !errors.Is(err, queue.ErrIndexOutOfRange) {
+		t.Errorf("The error is not ErrIndexOutOfRange, %v", err)
+		return
+	}
+
+	var spscQueue queue.IRingQueue = queue.NewSPSCRingQueue(4)
+	if _, ok := spscQueue.(queue.Indexable); ok {
+		t.Error("The SPSC ring queue can not modify values by index but implements queue.Indexable")
+		return
+	}
+	spscSafetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return spscQueue
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := spscSafetyQueue.PushValues(0, 1); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if value, err := spscSafetyQueue.Get(1); err != nil || value != 1 {
+		t.Errorf("The value at the index does not match the result, %v", err)
+		return
+	}
+	if _, err := spscSafetyQueue.Get(2); !errors.As(err, &indexErr) || indexErr.Index != 2 || indexErr.Length != 2 {
+		t.Errorf("The error is not a valid index error, %v", err)
+		return
+	}
+	if err := spscSafetyQueue.InsertAt(0, 0); !errors.Is(err, queue.ErrUnsupportedOperation) {
+		t.Errorf("The error is not ErrUnsupportedOperation, %v", err)
+		return
+	}
+	if _, err := spscSafetyQueue.RemoveAt(0); !errors.Is(err, queue.ErrUnsupportedOperation) {
+		t.Errorf("The error is not ErrUnsupportedOperation, %v", err)
+		return
+	}
+}
+
+func TestPriorityQueueIndexAccess_3(t *testing.T) {
+	priorityQueue, newQueueErr := queue.NewGenericPriorityQueue[int](-1, func(a, b int) bool {
+		return a < b
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a priority queue, %v", newQueueErr)
+		return
+	}
+	if err := priorityQueue.PushValues(7, 3, 9, 1, 5); err != nil {
+		t.Errorf("Failed to push values to the priority queue, %v", err)
+		return
+	}
+
+	if value, err := priorityQueue.Get(2); err != nil || value != 5 {
+		t.Errorf("The value at the index does not match the result, %v", err)
+		return
+	}
+	if value, err := priorityQueue.RemoveAt(1); err != nil || value != 3 {
+		t.Errorf("Failed to remove the value, %v", err)
+		return
+	}
+	if _, ok := any(priorityQueue).(queue.GenericIndexable[int]); ok {
+		t.Error("The priority queue orders values by priority but implements queue.GenericIndexable")
+		return
+	}
+
+	expectedValues := []int{1, 5, 7, 9}
+	poppedValues := priorityQueue.PopValues(len(expectedValues) + 1)
+	if len(poppedValues) != len(expectedValues) {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+	for idx, v := range poppedValues {
+		if v != expectedValues[idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"testing"
+)
+
+func TestSafetyRingQueueScanElements_1(t *testing.T) {
+	ringQueueCapacitySize := 8
+
+	newRingQueueFuncs := map[string]func() queue.IRingQueue{
+		"RingQueue": func() queue.IRingQueue {
+			return queue.NewRingQueue(ringQueueCapacitySize)
+		},
+		"SPSCRingQueue": func() queue.IRingQueue {
+			return queue.NewSPSCRingQueue(ringQueueCapacitySize - 1)
+		},
+		"PriorityQueue": func() queue.IRingQueue {
+			return newIntPriorityQueue(t, ringQueueCapacitySize-1)
+		},
+	}
+
+	for name, newRingQueueFunc := range newRingQueueFuncs {
+		safetyQueue, newQueueErr := queue.NewSafetyRingDeque(newRingQueueFunc)
+		if newQueueErr != nil {
+			t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+			return
+		}
+
+		if err := safetyQueue.PushValues(-1, -1, -1, -1, -1); err != nil {
+			t.Errorf("%s: failed to push values to the ring queue, %v", name, err)
+			return
+		}
+		safetyQueue.PopValues(5)
+		elemValues := []interface{}{0, 1, 2, 3, 4, 5}
+		if err := safetyQueue.PushValues(elemValues...); err != nil {
+			t.Errorf("%s: failed to push values to the ring queue, %v", name, err)
+			return
+		}
+
+		var scannedValues []interface{}
+		if err := safetyQueue.ScanElements(func(value interface{}) bool {
+			scannedValues = append(scannedValues, value)
+			return true
+		}); err != nil {
+			t.Errorf("%s: failed to scan elements, %v", name, err)
+			return
+		}
+		if len(scannedValues) != len(elemValues) {
+			t.Errorf("%s: the number of scanned values is incorrect", name)
+			return
+		}
+		for idx, v := range scannedValues {
+			if v != elemValues[idx] {
+				t.Errorf("%s: the scanned values are not in logical order", name)
+				return
+			}
+		}
+
+		scannedValues = scannedValues[:0]
+		if err := safetyQueue.ScanElementsReverse(func(value interface{}) bool {
+			scannedValues = append(scannedValues, value)
+			return len(scannedValues) < 2
+		}); err != nil {
+			t.Errorf("%s: failed to scan elements, %v", name, err)
+			return
+		}
+		if len(scannedValues) != 2 || scannedValues[0] != 5 || scannedValues[1] != 4 {
+			t.Errorf("%s: the reverse scan does not match the result, %v", name, scannedValues)
+			return
+		}
+
+		var scannedCount int
+		if err := safetyQueue.ScanElementsWithIndex(func(index int, value interface{}) bool {
+			if index != scannedCount || value != elemValues[index] {
+				t.Errorf("%s: the scanned index does not match the value", name)
+				return false
+			}
+			scannedCount += 1
+			return index < 2
+		}); err != nil {
+			t.Errorf("%s: failed to scan elements, %v", name, err)
+			return
+		}
+		if scannedCount != 3 {
+			t.Errorf("%s: the scan did not stop when the callback returned false", name)
+			return
+		}
+	}
+}
+
+func TestRingQueueScanElementsWithException_2(t *testing.T) {
+	ringQueue := queue.NewRingQueue(4)
+	if err := ringQueue.ScanElements(nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("The error is not ErrNilFunc, %v", err)
+		return
+	}
+	if err := ringQueue.ScanElementsWithIndex(nil); !errors.Is(err, queue.ErrNilFunc) {
+		t.Errorf("The error is not ErrNilFunc, %v", err)
+		return
+	}
+
+	var mpmcQueue queue.IRingQueue = queue.NewMPMCRingQueue(4)
+	if _, ok := mpmcQueue.(queue.Scannable); ok {
+		t.Error("The MPMC ring queue can not scan but implements queue.Scannable")
+		return
+	}
+	safetyQueue, newQueueErr := queue.NewSafetyRingDeque(func() queue.IRingQueue {
+		return mpmcQueue
+	})
+	if newQueueErr != nil {
+		t.Errorf("Failed to create a safety ring queue, %v", newQueueErr)
+		return
+	}
+	if err := safetyQueue.ScanElements(func(value interface{}) bool { return true }); !errors.Is(err, queue.ErrUnsupportedOperation) {
+		t.Errorf("The error is not ErrUnsupportedOperation, %v", err)
+		return
+	}
+}
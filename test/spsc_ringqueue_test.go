@@ -0,0 +1,103 @@
+package test
+
+import (
+	"errors"
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestSPSCRingQueuePushValues_1(t *testing.T) {
+	ringQueueCapacitySize := 10
+
+	var ringQueue queue.IRingQueue = queue.NewSPSCRingQueue(ringQueueCapacitySize)
+
+	var elemValues []interface{}
+	for i := 0; i < ringQueueCapacitySize; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := ringQueue.PushValues(elemValues...); err != nil {
+		t.Errorf("Failed to push values to the ring queue, %v", err)
+		return
+	}
+	if !ringQueue.IsFull() || ringQueue.GetAvailableCapacitySize() != 0 {
+		t.Error("The ring queue should be full")
+		return
+	}
+	if err := ringQueue.PushValue(0); !errors.Is(err, queue.ErrFull) {
+		t.Errorf("The error is not ErrFull, %v", err)
+		return
+	}
+
+	poppedValues := ringQueue.PopValues(ringQueueCapacitySize / 2)
+	if err := ringQueue.PushValues(elemValues...); !errors.Is(err, queue.ErrInsufficientCapacity) {
+		t.Errorf("The error is not ErrInsufficientCapacity, %v", err)
+		return
+	}
+
+	popListSpace := make([]interface{}, 0, ringQueueCapacitySize)
+	poppedCount, popErr := ringQueue.PopValuesToListSpace(&popListSpace)
+	if popErr != nil {
+		t.Errorf("Failed to pop values to list space, %v", popErr)
+		return
+	}
+	poppedValues = append(poppedValues, popListSpace[:poppedCount]...)
+
+	for idx, v := range poppedValues {
+		if v != elemValues[idx] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if !ringQueue.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestSPSCRingQueueConcurrent_2(t *testing.T) {
+	ringQueueCapacitySize := 64
+	elemValuesLen := 100000
+
+	ringQueue := queue.NewGenericSPSCRingQueue[int](ringQueueCapacitySize)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		batch := make([]int, 0, 8)
+		for i := 0; i < elemValuesLen; {
+			if i%3 == 0 {
+				batch = batch[:0]
+				for j := i; j < elemValuesLen && len(batch) < cap(batch); j++ {
+					batch = append(batch, j)
+				}
+				if ringQueue.PushValues(batch...) == nil {
+					i += len(batch)
+					continue
+				}
+			} else if ringQueue.PushValue(i) == nil {
+				i += 1
+				continue
+			}
+			runtime.Gosched()
+		}
+	}()
+
+	for expectedValue := 0; expectedValue < elemValuesLen; {
+		value, ok := ringQueue.PopValue()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		if value != expectedValue {
+			t.Errorf("The pop-up value does not match the result, %d != %d", value, expectedValue)
+			break
+		}
+		expectedValue += 1
+	}
+	wg.Wait()
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"github.com/akley-MK4/go-data-structure/queue"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWorkStealingDequePushValues_1(t *testing.T) {
+	elemValuesLen := 100
+
+	deque := queue.NewWorkStealingDeque()
+
+	var elemValues []interface{}
+	for i := 0; i < elemValuesLen; i++ {
+		elemValues = append(elemValues, i)
+	}
+
+	if err := deque.PushValuesToBack(elemValues...); err != nil {
+		t.Errorf("Failed to push the value to queue back, %v", err)
+		return
+	}
+	if deque.GetLength() != elemValuesLen {
+		t.Error("Wrong number of remaining elements")
+		return
+	}
+
+	stolenValues := deque.StealValuesFromFront(elemValuesLen / 2)
+	for idx, v := range stolenValues {
+		if v != elemValues[idx] {
+			t.Error("The stolen value does not match the result")
+			return
+		}
+	}
+
+	poppedValues := deque.PopValuesFromBack(elemValuesLen)
+	if len(poppedValues) != elemValuesLen-len(stolenValues) {
+		t.Error("The number of popped values is incorrect")
+		return
+	}
+	for idx, v := range poppedValues {
+		if v != elemValues[elemValuesLen-idx-1] {
+			t.Error("The pop-up value does not match the result")
+			return
+		}
+	}
+
+	if _, ok := deque.StealValueFromFront(); ok || !deque.IsEmpty() {
+		t.Error("Not all queue elements popped up")
+		return
+	}
+}
+
+func TestWorkStealingDequeConcurrent_2(t *testing.T) {
+	thiefNum := 4
+	elemValuesLen := 100000
+
+	deque := queue.NewGenericWorkStealingDeque[int]()
+	seen := make([]int32, elemValuesLen)
+	var takenCount int64
+
+	var wg sync.WaitGroup
+	for i := 0; i < thiefNum; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt64(&takenCount) < int64(elemValuesLen) {
+				value, ok := deque.StealValueFromFront()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				atomic.AddInt32(&seen[value], 1)
+				atomic.AddInt64(&takenCount, 1)
+			}
+		}()
+	}
+
+	// The owner pushes in bursts so the buffer grows while thieves are active
+	for i := 0; i < elemValuesLen; i++ {
+		_ = deque.PushValueToBack(i)
+		if i%7 != 0 {
+			continue
+		}
+		if value, ok := deque.PopValueFromBack(); ok {
+			atomic.AddInt32(&seen[value], 1)
+			atomic.AddInt64(&takenCount, 1)
+		}
+	}
+	for atomic.LoadInt64(&takenCount) < int64(elemValuesLen) {
+		value, ok := deque.PopValueFromBack()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		atomic.AddInt32(&seen[value], 1)
+		atomic.AddInt64(&takenCount, 1)
+	}
+	wg.Wait()
+
+	for value, count := range seen {
+		if count != 1 {
+			t.Errorf("The value %d was taken %d times", value, count)
+			return
+		}
+	}
+}